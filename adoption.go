@@ -0,0 +1,91 @@
+package semver
+
+import (
+	"math"
+	"sort"
+)
+
+// Adoption is the result of AnalyzeAdoption.
+type Adoption struct {
+	// Latest is the release with the highest precedence, it is nil if there are no releases without pre-release.
+	Latest *Version
+	// Total is the number of versions in the corpus.
+	Total int
+	// BehindMajor is the share (0 to 1) of versions with a lower major version than Latest.
+	BehindMajor float64
+	// BehindMinor is the share (0 to 1) of versions on an older minor line than Latest (including an older major).
+	BehindMinor float64
+
+	// staleness is the sorted number of releases newer than each version of the corpus.
+	staleness []int
+}
+
+// AnalyzeAdoption computes statistics of a corpus of versions in use (e.g. reported by clients, with a version
+// repeated for each client) relative to the published releases, e.g. for adoption dashboards.
+// The staleness of a version is the number of releases without pre-release that have a higher precedence.
+func AnalyzeAdoption(corpus []*Version, releases []*Version) Adoption {
+	var stable []*Version
+	for _, r := range releases {
+		if r.PreRelease == "" {
+			stable = append(stable, r)
+		}
+	}
+	sort.SliceStable(stable, func(i, j int) bool { return stable[i].Before(stable[j]) })
+
+	a := Adoption{Total: len(corpus)}
+	if len(stable) == 0 || len(corpus) == 0 {
+		if len(stable) > 0 {
+			a.Latest = stable[len(stable)-1]
+		}
+		return a
+	}
+	a.Latest = stable[len(stable)-1]
+
+	var behindMajor, behindMinor int
+	a.staleness = make([]int, 0, len(corpus))
+	for _, v := range corpus {
+		if v.Major < a.Latest.Major {
+			behindMajor++
+		}
+		if v.Major < a.Latest.Major || v.Major == a.Latest.Major && v.Minor < a.Latest.Minor {
+			behindMinor++
+		}
+		newer := sort.Search(len(stable), func(i int) bool { return v.Before(stable[i]) })
+		a.staleness = append(a.staleness, len(stable)-newer)
+	}
+	sort.Ints(a.staleness)
+
+	a.BehindMajor = float64(behindMajor) / float64(len(corpus))
+	a.BehindMinor = float64(behindMinor) / float64(len(corpus))
+	return a
+}
+
+// MedianStaleness returns the median number of newer releases over the corpus, it is 0 for an empty corpus.
+func (a Adoption) MedianStaleness() float64 {
+	n := len(a.staleness)
+	if n == 0 {
+		return 0
+	}
+	if n%2 == 1 {
+		return float64(a.staleness[n/2])
+	}
+	return float64(a.staleness[n/2-1]+a.staleness[n/2]) / 2
+}
+
+// StalenessPercentile returns the p-th percentile (0 to 100) of the number of newer releases over the corpus using
+// the nearest-rank method, e.g. 90 for "90% of the versions are at most this many releases behind".
+// It is 0 for an empty corpus.
+func (a Adoption) StalenessPercentile(p float64) int {
+	n := len(a.staleness)
+	if n == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(n)))
+	if rank < 1 {
+		rank = 1
+	}
+	if rank > n {
+		rank = n
+	}
+	return a.staleness[rank-1]
+}
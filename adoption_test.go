@@ -0,0 +1,51 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestAnalyzeAdoption(t *testing.T) {
+	parse := func(versions ...string) []*semver.Version {
+		var result []*semver.Version
+		for _, s := range versions {
+			result = append(result, mustParse(t, s))
+		}
+		return result
+	}
+
+	releases := parse("1.0.0", "1.1.0", "2.0.0", "2.1.0-rc.1", "2.1.0", "2.1.1", "3.0.0-rc.1")
+	corpus := parse("2.1.1", "2.1.1", "2.1.0", "2.0.0", "1.1.0", "1.0.0", "2.1.1+linux", "2.1.0-rc.1")
+
+	a := semver.AnalyzeAdoption(corpus, releases)
+	if a.Latest.String() != "2.1.1" {
+		t.Errorf("Expected latest %q, got %q", "2.1.1", a.Latest)
+	}
+	if a.Total != 8 {
+		t.Errorf("Expected total 8, got %d", a.Total)
+	}
+	if a.BehindMajor != 0.25 {
+		t.Errorf("Expected 0.25 behind major, got %v", a.BehindMajor)
+	}
+	if a.BehindMinor != 0.375 {
+		t.Errorf("Expected 0.375 behind minor, got %v", a.BehindMinor)
+	}
+	// Staleness sorted: 0 0 0 1 2 2 3 4
+	if m := a.MedianStaleness(); m != 1.5 {
+		t.Errorf("Expected median staleness 1.5, got %v", m)
+	}
+	if p := a.StalenessPercentile(90); p != 4 {
+		t.Errorf("Expected 90th percentile 4, got %d", p)
+	}
+	if p := a.StalenessPercentile(50); p != 1 {
+		t.Errorf("Expected 50th percentile 1, got %d", p)
+	}
+}
+
+func TestAnalyzeAdoption_Empty(t *testing.T) {
+	a := semver.AnalyzeAdoption(nil, nil)
+	if a.Latest != nil || a.MedianStaleness() != 0 || a.StalenessPercentile(50) != 0 {
+		t.Errorf("Expected empty statistics, got %+v", a)
+	}
+}
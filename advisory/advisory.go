@@ -0,0 +1,223 @@
+// Package advisory imports the affected version ranges of security advisories.
+//
+// Ranges of the GitHub Security Advisory database (GHSA) are parsed with ParseGHSARange, configurations of the
+// National Vulnerability Database (NVD) are converted with Configurations.Ranges. Both result in semver.Range values,
+// which implement semver.Matcher and can be used in a semver.Policy. A union of ranges can be simplified with
+// Ranges.Simplify and exported to and imported from the events of an OSV advisory with Ranges.OSVEvents and
+// ParseOSVEvents. For dashboards, Ranges.Segments clips a union to the displayed versions and Ranges.Timeline renders
+// it as text.
+package advisory
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/cpe"
+)
+
+// ErrUnsupportedEcosystem is returned for ecosystems that don't use semantic versions (e.g. pip or Maven).
+var ErrUnsupportedEcosystem = errors.New("ecosystem does not use semantic versions")
+
+// Ranges is a union of version ranges, it matches a version that is in any of the ranges.
+// An empty union matches no version.
+type Ranges []semver.Range
+
+// Match implements semver.Matcher.
+func (r Ranges) Match(v *semver.Version) bool {
+	for _, rng := range r {
+		if rng.Match(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// Simplify returns the union with overlapping or adjacent ranges merged and empty ranges removed, sorted by lower
+// bound, see semver.MergeRanges. It matches the same versions.
+func (r Ranges) Simplify() Ranges {
+	return semver.MergeRanges(r)
+}
+
+// String returns the union as the source of an equivalent semver.Expr, e.g.
+// `version >= "1.0.0" && version < "1.2.3" || version >= "2.0.0" && version < "2.0.4"`.
+func (r Ranges) String() string {
+	if len(r) == 0 {
+		return "major < 0"
+	}
+	parts := make([]string, len(r))
+	for i, rng := range r {
+		parts[i] = rng.String()
+	}
+	return strings.Join(parts, " || ")
+}
+
+// Segments returns the parts of the union within the universe, e.g. the versions shown on the axis of a timeline, as
+// sorted segments with their bounds. Unbounded sides of the universe keep the segments unbounded.
+func (r Ranges) Segments(universe semver.Range) Ranges {
+	var segments Ranges
+	for _, rng := range r {
+		segments = append(segments, rng.Intersect(universe))
+	}
+	return segments.Simplify()
+}
+
+// Timeline renders the union as a line of text for a command line, with a column for each of the versions in
+// ascending order: "#" if the version is in a range and "." if not, e.g. "1.0.0 ##..#. 2.1.0". It returns an empty
+// string without versions.
+func (r Ranges) Timeline(versions []*semver.Version) string {
+	if len(versions) == 0 {
+		return ""
+	}
+	sorted := slices.Clone(versions)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Before(sorted[j]) })
+
+	var sb strings.Builder
+	sb.WriteString(sorted[0].String())
+	sb.WriteByte(' ')
+	for _, v := range sorted {
+		if r.Match(v) {
+			sb.WriteByte('#')
+		} else {
+			sb.WriteByte('.')
+		}
+	}
+	sb.WriteByte(' ')
+	sb.WriteString(sorted[len(sorted)-1].String())
+	return sb.String()
+}
+
+// StringDialect renders the union in the syntax of a package manager, see semver.Range.StringDialect. The ranges are
+// joined with "||" for npm and Composer, Cargo can't express a union of more than one range. It returns an error for
+// an empty union.
+func (r Ranges) StringDialect(d semver.Dialect) (string, error) {
+	simplified := r.Simplify()
+	switch {
+	case len(simplified) == 0:
+		return "", errors.New("union of ranges is empty")
+	case len(simplified) > 1 && d == semver.DialectCargo:
+		return "", fmt.Errorf("%s can't express a union of %d ranges", d, len(simplified))
+	}
+	parts := make([]string, len(simplified))
+	for i, rng := range simplified {
+		s, err := rng.StringDialect(d)
+		if err != nil {
+			return "", err
+		}
+		parts[i] = s
+	}
+	return strings.Join(parts, " || "), nil
+}
+
+// ParseGHSARange parses a vulnerable_version_range of a GHSA advisory, e.g. ">= 1.0.0, < 1.2.3" or "= 2.0.0".
+// The ecosystem (as named by GHSA, e.g. "npm", "go" or "rust") selects the version dialect: Go versions may have a
+// "v" prefix and versions with omitted components like "1.2" are completed with zeros.
+func ParseGHSARange(ecosystem, vulnerableRange string) (semver.Range, error) {
+	prefix, err := ecosystemPrefix(ecosystem)
+	if err != nil {
+		return semver.Range{}, err
+	}
+
+	var r semver.Range
+	for _, part := range strings.Split(vulnerableRange, ",") {
+		part = strings.TrimSpace(part)
+		op, version, found := strings.Cut(part, " ")
+		if !found {
+			return semver.Range{}, fmt.Errorf("invalid range %q: expected operator and version in %q", vulnerableRange, part)
+		}
+		v, err := parseVersion(strings.TrimPrefix(strings.TrimSpace(version), prefix))
+		if err != nil {
+			return semver.Range{}, fmt.Errorf("invalid range %q: %w", vulnerableRange, err)
+		}
+
+		switch op {
+		case ">=", ">":
+			if r.Lower != nil {
+				return semver.Range{}, fmt.Errorf("invalid range %q: multiple lower bounds", vulnerableRange)
+			}
+			r.Lower, r.LowerInclusive = v, op == ">="
+		case "<=", "<":
+			if r.Upper != nil {
+				return semver.Range{}, fmt.Errorf("invalid range %q: multiple upper bounds", vulnerableRange)
+			}
+			r.Upper, r.UpperInclusive = v, op == "<="
+		case "=":
+			if r.Lower != nil || r.Upper != nil {
+				return semver.Range{}, fmt.Errorf("invalid range %q: = must be the only comparison", vulnerableRange)
+			}
+			r = semver.Range{Lower: v, LowerInclusive: true, Upper: v, UpperInclusive: true}
+		default:
+			return semver.Range{}, fmt.Errorf("invalid range %q: unknown operator %q", vulnerableRange, op)
+		}
+	}
+	return r, nil
+}
+
+// ecosystemPrefix returns the optional version prefix of a GHSA ecosystem that uses semantic versions.
+func ecosystemPrefix(ecosystem string) (string, error) {
+	switch strings.ToLower(ecosystem) {
+	case "go":
+		return "v", nil
+	case "npm", "rust", "crates.io", "composer", "nuget", "pub", "swift", "erlang", "hex":
+		return "", nil
+	}
+	return "", fmt.Errorf("%w: %s", ErrUnsupportedEcosystem, ecosystem)
+}
+
+func parseVersion(s string) (*semver.Version, error) {
+	pv, err := semver.ParsePartial(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version %q: %w", s, err)
+	}
+	return pv.Version(), nil
+}
+
+// Configurations are the configurations of an NVD CVE item.
+type Configurations []Configuration
+
+// Configuration is a configuration of an NVD CVE item.
+type Configuration struct {
+	Operator string `json:"operator,omitempty"`
+	Nodes    []Node `json:"nodes"`
+}
+
+// Node is a node of an NVD configuration with CPE match criteria.
+type Node struct {
+	Operator string      `json:"operator"`
+	Negate   bool        `json:"negate,omitempty"`
+	CPEMatch []cpe.Match `json:"cpeMatch"`
+}
+
+// Ranges returns the ranges of the vulnerable match criteria for the product of the vendor.
+// Criteria that are not vulnerable (e.g. the platform a product must run on) and negated nodes are skipped.
+func (c Configurations) Ranges(vendor, product string) (Ranges, error) {
+	var ranges Ranges
+	for _, configuration := range c {
+		for _, node := range configuration.Nodes {
+			if node.Negate {
+				continue
+			}
+			for _, m := range node.CPEMatch {
+				if !m.Vulnerable {
+					continue
+				}
+				name, err := cpe.ParseName(m.Criteria)
+				if err != nil {
+					return nil, err
+				}
+				if name.Vendor != vendor || name.Product != product {
+					continue
+				}
+				r, err := m.Range()
+				if err != nil {
+					return nil, err
+				}
+				ranges = append(ranges, r)
+			}
+		}
+	}
+	return ranges, nil
+}
@@ -0,0 +1,177 @@
+package advisory_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/advisory"
+)
+
+func mustParse(t *testing.T, s string) *semver.Version {
+	t.Helper()
+	v, err := semver.ParseVersion(s)
+	if err != nil {
+		t.Fatalf("Error parsing version %q: %v", s, err)
+	}
+	return v
+}
+
+func TestParseGHSARange(t *testing.T) {
+	tests := []struct {
+		ecosystem   string
+		input       string
+		expected    string
+		expectedErr string
+	}{
+		{"npm", ">= 1.0.0, < 1.2.3", `version >= "1.0.0" && version < "1.2.3"`, ""},
+		{"go", "< v0.17.0", `version < "0.17.0"`, ""},
+		{"rust", "<= 2.0", `version <= "2.0.0"`, ""},
+		{"npm", "= 4.17.20", `version >= "4.17.20" && version <= "4.17.20"`, ""},
+		{"npm", "> 1.0.0, > 1.1.0", "", `invalid range "> 1.0.0, > 1.1.0": multiple lower bounds`},
+		{"npm", "~> 1.0", "", `invalid range "~> 1.0": unknown operator "~>"`},
+		{"npm", "<1.0.0", "", `invalid range "<1.0.0": expected operator and version in "<1.0.0"`},
+		{"pip", "< 2.0", "", "ecosystem does not use semantic versions: pip"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.ecosystem+" "+test.input, func(t *testing.T) {
+			r, err := advisory.ParseGHSARange(test.ecosystem, test.input)
+			if err != nil {
+				if err.Error() != test.expectedErr {
+					t.Errorf("Expected error %q, got %q", test.expectedErr, err)
+				}
+				return
+			}
+			if test.expectedErr != "" {
+				t.Fatalf("Expected error %q, got nil", test.expectedErr)
+			}
+			if r.String() != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, r)
+			}
+		})
+	}
+
+	if _, err := advisory.ParseGHSARange("maven", "< 1.0"); !errors.Is(err, advisory.ErrUnsupportedEcosystem) {
+		t.Errorf("Expected ErrUnsupportedEcosystem, got %v", err)
+	}
+}
+
+func TestConfigurations_Ranges(t *testing.T) {
+	var configurations advisory.Configurations
+	err := json.Unmarshal([]byte(`[
+  {
+    "operator": "AND",
+    "nodes": [
+      {"operator": "OR", "cpeMatch": [
+        {"vulnerable": true, "criteria": "cpe:2.3:a:example:server:*:*:*:*:*:*:*:*", "versionStartIncluding": "2.0", "versionEndExcluding": "2.4.1"},
+        {"vulnerable": true, "criteria": "cpe:2.3:a:example:server:3.0.0:*:*:*:*:*:*:*"},
+        {"vulnerable": true, "criteria": "cpe:2.3:a:example:client:*:*:*:*:*:*:*:*", "versionEndExcluding": "9.0"}
+      ]},
+      {"operator": "OR", "cpeMatch": [
+        {"vulnerable": false, "criteria": "cpe:2.3:o:linux:linux_kernel:*:*:*:*:*:*:*:*"}
+      ]}
+    ]
+  }
+]`), &configurations)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	ranges, err := configurations.Ranges("example", "server")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(ranges) != 2 {
+		t.Fatalf("Expected 2 ranges, got %d", len(ranges))
+	}
+	for s, expected := range map[string]bool{"2.0.0": true, "2.4.0": true, "2.4.1": false, "3.0.0": true, "3.0.1": false} {
+		if matched := ranges.Match(mustParse(t, s)); matched != expected {
+			t.Errorf("Expected %s to match: %v, got %v", s, expected, matched)
+		}
+	}
+
+	none, err := configurations.Ranges("example", "unknown")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if none.Match(mustParse(t, "1.0.0")) {
+		t.Errorf("Expected empty ranges to match no version")
+	}
+}
+
+func TestRanges_Simplify(t *testing.T) {
+	var ranges advisory.Ranges
+	for _, s := range []string{">= 1.4.0, < 2.0.0", ">= 1.0.0, < 1.5.0", ">= 3.0.0, < 3.1.0"} {
+		r, err := advisory.ParseGHSARange("npm", s)
+		if err != nil {
+			t.Fatalf("Error parsing range %q: %v", s, err)
+		}
+		ranges = append(ranges, r)
+	}
+
+	simplified := ranges.Simplify()
+	expected := `version >= "1.0.0" && version < "2.0.0" || version >= "3.0.0" && version < "3.1.0"`
+	if simplified.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, simplified)
+	}
+
+	expr, err := semver.CompileExpr(simplified.String())
+	if err != nil {
+		t.Fatalf("Error compiling %q: %v", simplified, err)
+	}
+	for _, s := range []string{"0.9.0", "1.0.0", "1.4.5", "1.9.9", "2.0.0", "3.0.5", "3.1.0"} {
+		v := mustParse(t, s)
+		if ranges.Match(v) != simplified.Match(v) || ranges.Match(v) != expr.Match(v) {
+			t.Errorf("Expected simplified ranges to match %s like the ranges", s)
+		}
+	}
+
+	if s := (advisory.Ranges{}).String(); s != "major < 0" {
+		t.Errorf("Expected empty union to match no version, got %q", s)
+	}
+}
+
+func TestRanges_StringDialect(t *testing.T) {
+	ranges := advisory.Ranges{
+		{Lower: mustParse(t, "2.0.0"), LowerInclusive: true, Upper: mustParse(t, "2.0.4")},
+		{Lower: mustParse(t, "1.0.0"), LowerInclusive: true, Upper: mustParse(t, "1.2.3")},
+	}
+
+	result, err := ranges.StringDialect(semver.DialectNPM)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result != ">=1.0.0 <1.2.3 || >=2.0.0 <2.0.4" {
+		t.Errorf("Expected npm union, got %q", result)
+	}
+
+	if _, err := ranges.StringDialect(semver.DialectCargo); err == nil || err.Error() != "cargo can't express a union of 2 ranges" {
+		t.Errorf("Expected union error, got %v", err)
+	}
+	if _, err := (advisory.Ranges{}).StringDialect(semver.DialectNPM); err == nil || err.Error() != "union of ranges is empty" {
+		t.Errorf("Expected empty union error, got %v", err)
+	}
+}
+
+func TestRanges_Segments(t *testing.T) {
+	ranges := advisory.Ranges{
+		{Upper: mustParse(t, "1.2.3")},
+		{Lower: mustParse(t, "2.0.0"), LowerInclusive: true},
+	}
+	universe := semver.Range{Lower: mustParse(t, "1.0.0"), LowerInclusive: true, Upper: mustParse(t, "3.0.0"), UpperInclusive: true}
+
+	expected := `version >= "1.0.0" && version < "1.2.3" || version >= "2.0.0" && version <= "3.0.0"`
+	if segments := ranges.Segments(universe); segments.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, segments)
+	}
+
+	var versions []*semver.Version
+	for _, s := range []string{"2.1.0", "1.0.0", "1.2.3", "1.2.0", "2.0.0", "1.5.0"} {
+		versions = append(versions, mustParse(t, s))
+	}
+	if timeline := ranges.Timeline(versions); timeline != "1.0.0 ##..## 2.1.0" {
+		t.Errorf("Expected timeline, got %q", timeline)
+	}
+}
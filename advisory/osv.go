@@ -0,0 +1,108 @@
+package advisory
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/networkteam/semver"
+)
+
+// OSVEvent is an event of an OSV range of type SEMVER, exactly one of its fields is set.
+type OSVEvent struct {
+	// Introduced is the first affected version, "0" for all versions before the next event.
+	Introduced string `json:"introduced,omitempty"`
+	// Fixed is the first version that is not affected anymore.
+	Fixed string `json:"fixed,omitempty"`
+	// LastAffected is the last affected version.
+	LastAffected string `json:"last_affected,omitempty"`
+}
+
+// OSVEvents returns the events of an OSV range of type SEMVER matching the same versions as the union, e.g. to
+// publish an advisory. The union is simplified first, so the events are sorted. It returns an error if a range has an
+// exclusive lower bound, which OSV can't express.
+func (r Ranges) OSVEvents() ([]OSVEvent, error) {
+	var events []OSVEvent
+	for _, rng := range r.Simplify() {
+		switch {
+		case rng.Lower == nil:
+			events = append(events, OSVEvent{Introduced: "0"})
+		case rng.LowerInclusive:
+			events = append(events, OSVEvent{Introduced: rng.Lower.String()})
+		default:
+			return nil, fmt.Errorf("range %s has an exclusive lower bound", rng)
+		}
+
+		switch {
+		case rng.Upper == nil:
+		case rng.UpperInclusive:
+			events = append(events, OSVEvent{LastAffected: rng.Upper.String()})
+		default:
+			events = append(events, OSVEvent{Fixed: rng.Upper.String()})
+		}
+	}
+	return events, nil
+}
+
+// ParseOSVEvents converts the events of an OSV range of type SEMVER to a union of ranges. Like in OSV, the events
+// are evaluated in the order of their versions and a version is affected if the closest event at or below it
+// introduces a range.
+func ParseOSVEvents(events []OSVEvent) (Ranges, error) {
+	type event struct {
+		version *semver.Version
+		OSVEvent
+	}
+	parsed := make([]event, len(events))
+	for i, e := range events {
+		s, err := e.version()
+		if err != nil {
+			return nil, err
+		}
+		if s == "0" && e.Introduced != "" {
+			parsed[i] = event{OSVEvent: e}
+			continue
+		}
+		v, err := semver.ParseVersionWithOptions(s, semver.ParseOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("invalid version %q: %w", s, err)
+		}
+		parsed[i] = event{version: v, OSVEvent: e}
+	}
+	sort.SliceStable(parsed, func(i, j int) bool {
+		a, b := parsed[i].version, parsed[j].version
+		return a == nil && b != nil || a != nil && b != nil && a.Before(b)
+	})
+
+	var ranges Ranges
+	var open *semver.Range
+	for _, e := range parsed {
+		switch {
+		case e.Introduced != "":
+			if open == nil {
+				open = &semver.Range{Lower: e.version, LowerInclusive: e.version != nil}
+			}
+		case open != nil:
+			open.Upper, open.UpperInclusive = e.version, e.LastAffected != ""
+			ranges = append(ranges, *open)
+			open = nil
+		}
+	}
+	if open != nil {
+		ranges = append(ranges, *open)
+	}
+	return ranges.Simplify(), nil
+}
+
+// version returns the version of the event, it returns an error if not exactly one field is set.
+func (e OSVEvent) version() (string, error) {
+	var versions []string
+	for _, s := range []string{e.Introduced, e.Fixed, e.LastAffected} {
+		if s != "" {
+			versions = append(versions, s)
+		}
+	}
+	if len(versions) != 1 {
+		return "", errors.New("invalid event: exactly one of introduced, fixed and last_affected must be set")
+	}
+	return versions[0], nil
+}
@@ -0,0 +1,97 @@
+package advisory_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/networkteam/semver/advisory"
+)
+
+func TestRanges_OSVEvents(t *testing.T) {
+	ranges := advisory.Ranges{
+		{Lower: mustParse(t, "2.0.0"), LowerInclusive: true, Upper: mustParse(t, "2.0.4"), UpperInclusive: true},
+		{Upper: mustParse(t, "1.2.3")},
+		{Lower: mustParse(t, "3.0.0"), LowerInclusive: true},
+	}
+
+	events, err := ranges.OSVEvents()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	data, err := json.Marshal(events)
+	if err != nil {
+		t.Fatalf("Error marshaling events: %v", err)
+	}
+	expected := `[{"introduced":"0"},{"fixed":"1.2.3"},{"introduced":"2.0.0"},{"last_affected":"2.0.4"},{"introduced":"3.0.0"}]`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+
+	parsed, err := advisory.ParseOSVEvents(events)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if parsed.String() != ranges.Simplify().String() {
+		t.Errorf("Expected %q, got %q", ranges.Simplify(), parsed)
+	}
+
+	_, err = advisory.Ranges{{Lower: mustParse(t, "1.0.0")}}.OSVEvents()
+	if err == nil || err.Error() != `range version > "1.0.0" has an exclusive lower bound` {
+		t.Errorf("Expected exclusive lower bound error, got %v", err)
+	}
+}
+
+func TestParseOSVEvents(t *testing.T) {
+	tests := []struct {
+		name        string
+		events      []advisory.OSVEvent
+		expected    string
+		expectedErr string
+	}{
+		{
+			"unsorted",
+			[]advisory.OSVEvent{{Fixed: "1.5.0"}, {Introduced: "2.0.0"}, {Introduced: "1.0.0"}, {Fixed: "2.1.0"}},
+			`version >= "1.0.0" && version < "1.5.0" || version >= "2.0.0" && version < "2.1.0"`,
+			"",
+		},
+		{
+			"repeated introduced",
+			[]advisory.OSVEvent{{Introduced: "0"}, {Introduced: "1.0.0"}, {LastAffected: "1.4.0"}},
+			`version <= "1.4.0"`,
+			"",
+		},
+		{
+			"invalid version",
+			[]advisory.OSVEvent{{Introduced: "1.0"}},
+			"",
+			`invalid version "1.0": invalid version core: missing dot separator (at position 3)`,
+		},
+		{
+			"invalid event",
+			[]advisory.OSVEvent{{Introduced: "1.0.0", Fixed: "2.0.0"}},
+			"",
+			"invalid event: exactly one of introduced, fixed and last_affected must be set",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ranges, err := advisory.ParseOSVEvents(test.events)
+			if err != nil {
+				if err.Error() != test.expectedErr {
+					t.Errorf("Expected error %q, got %q", test.expectedErr, err)
+				}
+				return
+			}
+			if test.expectedErr != "" {
+				t.Fatalf("Expected error %q, got nil", test.expectedErr)
+			}
+			if ranges.String() != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, ranges)
+			}
+			if !ranges.Match(mustParse(t, "1.2.0")) {
+				t.Errorf("Expected 1.2.0 to be affected")
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package advisory
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/networkteam/semver"
+)
+
+// Severity is the severity of an advisory.
+type Severity int
+
+const (
+	SeverityLow Severity = iota + 1
+	SeverityModerate
+	SeverityHigh
+	SeverityCritical
+)
+
+// ParseSeverity parses a severity as used by GHSA ("low", "moderate", "high", "critical"), "medium" is accepted for
+// moderate like in CVSS ratings.
+func ParseSeverity(s string) (Severity, error) {
+	switch strings.ToLower(s) {
+	case "low":
+		return SeverityLow, nil
+	case "moderate", "medium":
+		return SeverityModerate, nil
+	case "high":
+		return SeverityHigh, nil
+	case "critical":
+		return SeverityCritical, nil
+	}
+	return 0, fmt.Errorf("unknown severity %q", s)
+}
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityLow:
+		return "low"
+	case SeverityModerate:
+		return "moderate"
+	case SeverityHigh:
+		return "high"
+	case SeverityCritical:
+		return "critical"
+	default:
+		return "unknown"
+	}
+}
+
+// Advisory is a security advisory for a package.
+type Advisory struct {
+	ID       string
+	Severity Severity
+	// Affected matches the affected versions, e.g. Ranges or a semver.Range from ParseGHSARange.
+	Affected semver.Matcher
+	// Fixed are the versions that fix the advisory, usually one per maintained release line.
+	Fixed []*semver.Version
+}
+
+// Bump is the kind of an upgrade.
+type Bump int
+
+const (
+	BumpPatch Bump = iota + 1
+	BumpMinor
+	BumpMajor
+)
+
+func (b Bump) String() string {
+	switch b {
+	case BumpPatch:
+		return "patch"
+	case BumpMinor:
+		return "minor"
+	case BumpMajor:
+		return "major"
+	default:
+		return "unknown"
+	}
+}
+
+// Upgrade is a required upgrade of an installed version reported by Remediate.
+type Upgrade struct {
+	Installed *semver.Version
+	// Target is the version fixing all advisories, see Remediate. It is nil if there is none.
+	Target *semver.Version
+	// Bump is the kind of the upgrade to Target, it is zero if there is no target.
+	Bump Bump
+	// Advisories are the advisories affecting the installed version.
+	Advisories []Advisory
+}
+
+// RemediationOptions configure Remediate.
+type RemediationOptions struct {
+	// MinSeverity ignores advisories with a lower severity.
+	MinSeverity Severity
+	// Policy restricts the target versions, e.g. to exclude blocked versions. The zero value allows all versions.
+	Policy semver.Policy
+}
+
+// Remediate computes the upgrades needed for the installed versions to not be affected by any of the advisories
+// with at least the minimum severity. Each affected installed version gets the upgrade to a fixed version that is
+// allowed by the policy and not affected by any advisory, chosen with semver.FirstFixAtOrAbove to stay on the release
+// line of the installed version if possible.
+// Installed versions that are not affected are omitted, the upgrades are in the order of the installed versions.
+func Remediate(installed []*semver.Version, advisories []Advisory, opts RemediationOptions) []Upgrade {
+	var gated []Advisory
+	for _, a := range advisories {
+		if a.Severity >= opts.MinSeverity {
+			gated = append(gated, a)
+		}
+	}
+
+	var candidates []*semver.Version
+	for _, a := range gated {
+		for _, v := range a.Fixed {
+			if opts.Policy.Allows(v) && !affectedByAny(v, gated) {
+				candidates = append(candidates, v)
+			}
+		}
+	}
+
+	var upgrades []Upgrade
+	for _, v := range installed {
+		u := Upgrade{Installed: v}
+		for _, a := range gated {
+			if a.Affected.Match(v) {
+				u.Advisories = append(u.Advisories, a)
+			}
+		}
+		if len(u.Advisories) == 0 {
+			continue
+		}
+
+		if u.Target = semver.FirstFixAtOrAbove(v, candidates); u.Target != nil {
+			u.Bump = bumpOf(v, u.Target)
+		}
+		upgrades = append(upgrades, u)
+	}
+	return upgrades
+}
+
+func affectedByAny(v *semver.Version, advisories []Advisory) bool {
+	for _, a := range advisories {
+		if a.Affected.Match(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func bumpOf(from, to *semver.Version) Bump {
+	switch {
+	case from.Epoch != to.Epoch, from.Major != to.Major:
+		return BumpMajor
+	case from.Minor != to.Minor:
+		return BumpMinor
+	default:
+		return BumpPatch
+	}
+}
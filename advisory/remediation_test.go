@@ -0,0 +1,98 @@
+package advisory_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/advisory"
+)
+
+func TestRemediate(t *testing.T) {
+	ghsaRange := func(s string) semver.Range {
+		r, err := advisory.ParseGHSARange("npm", s)
+		if err != nil {
+			t.Fatalf("Error parsing range %q: %v", s, err)
+		}
+		return r
+	}
+	versions := func(ss ...string) []*semver.Version {
+		var result []*semver.Version
+		for _, s := range ss {
+			result = append(result, mustParse(t, s))
+		}
+		return result
+	}
+
+	advisories := []advisory.Advisory{
+		{
+			ID:       "GHSA-1",
+			Severity: advisory.SeverityHigh,
+			Affected: advisory.Ranges{ghsaRange("< 1.4.9"), ghsaRange(">= 2.0.0, < 2.0.1")},
+			Fixed:    versions("1.4.9", "2.0.1"),
+		},
+		{
+			ID:       "GHSA-2",
+			Severity: advisory.SeverityCritical,
+			Affected: ghsaRange(">= 1.4.9, < 1.5.2"),
+			Fixed:    versions("1.5.2"),
+		},
+		{
+			ID:       "GHSA-3",
+			Severity: advisory.SeverityLow,
+			Affected: ghsaRange("< 3.0.0"),
+			Fixed:    versions("3.0.0"),
+		},
+	}
+
+	tests := []struct {
+		name     string
+		opts     advisory.RemediationOptions
+		expected string
+	}{
+		{
+			"high and above",
+			advisory.RemediationOptions{MinSeverity: advisory.SeverityHigh},
+			"[1.4.2->1.5.2 minor [GHSA-1] 2.0.0->2.0.1 patch [GHSA-1]]",
+		},
+		{
+			"all severities",
+			advisory.RemediationOptions{},
+			"[1.4.2->3.0.0 major [GHSA-1 GHSA-3] 2.0.0->3.0.0 major [GHSA-1 GHSA-3] 2.1.0->3.0.0 major [GHSA-3]]",
+		},
+		{
+			"policy excludes fix",
+			advisory.RemediationOptions{
+				MinSeverity: advisory.SeverityHigh,
+				Policy:      semver.Policy{Exclude: []semver.Matcher{semver.MatchFunc(func(v *semver.Version) bool { return v.Major == 2 })}},
+			},
+			"[1.4.2->1.5.2 minor [GHSA-1] 2.0.0-><nil> unknown [GHSA-1]]",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			upgrades := advisory.Remediate(versions("1.4.2", "2.0.0", "2.1.0"), advisories, test.opts)
+			var got []string
+			for _, u := range upgrades {
+				var ids []string
+				for _, a := range u.Advisories {
+					ids = append(ids, a.ID)
+				}
+				got = append(got, fmt.Sprintf("%s->%v %s %v", u.Installed, u.Target, u.Bump, ids))
+			}
+			if fmt.Sprint(got) != test.expected {
+				t.Errorf("Expected %s, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseSeverity(t *testing.T) {
+	if s, err := advisory.ParseSeverity("MEDIUM"); err != nil || s != advisory.SeverityModerate {
+		t.Errorf("Expected moderate, got %v, %v", s, err)
+	}
+	if _, err := advisory.ParseSeverity("urgent"); err == nil {
+		t.Errorf("Expected error for unknown severity")
+	}
+}
@@ -0,0 +1,82 @@
+package semver
+
+import (
+	"strings"
+)
+
+// PreReleaseAliases is a comparison mode with custom meanings of pre-release identifiers, e.g. to order Maven or
+// Gradle flavored artifacts. Aliases are matched case-insensitively and are only used by Compare, all other
+// comparisons of the package follow the spec.
+// The zero value has no aliases and compares like the spec.
+type PreReleaseAliases struct {
+	stable map[string]bool
+	ranks  map[string]int
+}
+
+// Stable registers identifiers that rank equal to a release without pre-release when they are the only pre-release
+// identifier, e.g. "final", "ga" or "release".
+func (a *PreReleaseAliases) Stable(identifiers ...string) *PreReleaseAliases {
+	if a.stable == nil {
+		a.stable = make(map[string]bool)
+	}
+	for _, identifier := range identifiers {
+		a.stable[strings.ToLower(identifier)] = true
+	}
+	return a
+}
+
+// Rank registers an identifier with a rank. Ranked identifiers are ordered by rank among each other and before all
+// identifiers without a rank, so ranking "snapshot" orders 1.0.0-snapshot before 1.0.0-alpha.
+func (a *PreReleaseAliases) Rank(identifier string, rank int) *PreReleaseAliases {
+	if a.ranks == nil {
+		a.ranks = make(map[string]int)
+	}
+	a.ranks[strings.ToLower(identifier)] = rank
+	return a
+}
+
+// Compare compares two versions by precedence with the aliases applied and returns -1, 0 or 1.
+func (a *PreReleaseAliases) Compare(v, other *Version) int {
+	x := Version{Epoch: v.Epoch, Major: v.Major, Minor: v.Minor, Patch: v.Patch}
+	y := Version{Epoch: other.Epoch, Major: other.Major, Minor: other.Minor, Patch: other.Patch}
+	if result := compareVersions(&x, &y); result != 0 {
+		return result
+	}
+
+	xPreRelease := a.normalize(v.PreRelease)
+	yPreRelease := a.normalize(other.PreRelease)
+	if xPreRelease == "" || yPreRelease == "" {
+		return comparePreRelease(xPreRelease, yPreRelease)
+	}
+
+	xIdentifiers := strings.Split(xPreRelease, ".")
+	yIdentifiers := strings.Split(yPreRelease, ".")
+	for i := 0; i < len(xIdentifiers) && i < len(yIdentifiers); i++ {
+		if result := a.compareIdentifiers(xIdentifiers[i], yIdentifiers[i]); result != 0 {
+			return result
+		}
+	}
+	return compareInts(len(xIdentifiers), len(yIdentifiers))
+}
+
+func (a *PreReleaseAliases) normalize(preRelease string) string {
+	if a.stable[strings.ToLower(preRelease)] {
+		return ""
+	}
+	return preRelease
+}
+
+func (a *PreReleaseAliases) compareIdentifiers(x, y string) int {
+	xRank, xRanked := a.ranks[strings.ToLower(x)]
+	yRank, yRanked := a.ranks[strings.ToLower(y)]
+	switch {
+	case xRanked && yRanked:
+		return compareInts(xRank, yRank)
+	case xRanked:
+		return -1
+	case yRanked:
+		return 1
+	default:
+		return compareIdentifiers(x, y)
+	}
+}
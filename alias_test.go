@@ -0,0 +1,53 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestPreReleaseAliases_Compare(t *testing.T) {
+	aliases := (&semver.PreReleaseAliases{}).
+		Stable("final", "ga", "release").
+		Rank("snapshot", 0)
+
+	tests := []struct {
+		v1       string
+		v2       string
+		expected int
+	}{
+		{"1.0.0-final", "1.0.0", 0},
+		{"1.0.0-GA", "1.0.0-release", 0},
+		{"1.0.0-final", "1.0.1", -1},
+		{"1.0.0-rc.1", "1.0.0-final", -1},
+		{"1.0.0-snapshot", "1.0.0-alpha", -1},
+		{"1.0.0-SNAPSHOT", "1.0.0-1", -1},
+		{"1.0.0-alpha.snapshot", "1.0.0-alpha.1", -1},
+		{"1.0.0-alpha", "1.0.0-beta", -1},
+		{"1.0.0-beta.11", "1.0.0-beta.2", 1},
+		{"1.0.0-final.1", "1.0.0", -1},
+		{"2.0.0-snapshot", "1.0.0", 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.v1+" <=> "+test.v2, func(t *testing.T) {
+			v1 := mustParse(t, test.v1)
+			v2 := mustParse(t, test.v2)
+
+			if result := aliases.Compare(v1, v2); result != test.expected {
+				t.Errorf("Expected %d, got %d", test.expected, result)
+			}
+			if result := aliases.Compare(v2, v1); result != -test.expected {
+				t.Errorf("Expected inverse %d, got %d", -test.expected, result)
+			}
+		})
+	}
+}
+
+func TestPreReleaseAliases_ZeroValue(t *testing.T) {
+	var aliases semver.PreReleaseAliases
+
+	if result := aliases.Compare(mustParse(t, "1.0.0-final"), mustParse(t, "1.0.0")); result != -1 {
+		t.Errorf("Expected spec comparison without aliases, got %d", result)
+	}
+}
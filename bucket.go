@@ -0,0 +1,17 @@
+package semver
+
+import (
+	"hash/fnv"
+)
+
+// Bucket deterministically assigns the version to one of n buckets (0 to n-1), e.g. for staged rollouts keyed by
+// version. The bucket is derived from the precedence-relevant fields, so the build metadata does not change it.
+// It panics if n <= 0.
+func Bucket(v *Version, n int) int {
+	if n <= 0 {
+		panic("semver: invalid number of buckets")
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(v.precedenceString()))
+	return int(h.Sum32() % uint32(n))
+}
@@ -0,0 +1,47 @@
+package semver_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/semvertest"
+)
+
+func TestBucket(t *testing.T) {
+	tests := []struct {
+		version  string
+		n        int
+		expected int
+	}{
+		{"1.0.0", 10, 4},
+		{"1.0.0+build.1", 10, 4},
+		{"1.0.1", 10, 3},
+		{"2.0.0-rc.1", 100, 70},
+		{"2.0.0-rc.1", 1, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			if bucket := semver.Bucket(mustParse(t, test.version), test.n); bucket != test.expected {
+				t.Errorf("Expected bucket %d, got %d", test.expected, bucket)
+			}
+		})
+	}
+}
+
+func TestBucket_Distribution(t *testing.T) {
+	const n = 8
+	counts := make([]int, n)
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 8000; i++ {
+		counts[semver.Bucket(semvertest.Generate(r, semvertest.Options{PreRelease: true}), n)]++
+	}
+
+	for bucket, count := range counts {
+		if count < 800 || count > 1200 {
+			t.Errorf("Expected about 1000 versions in bucket %d, got %d", bucket, count)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BuildFields are key-value pairs encoded in build metadata by the convention of alternating keys and values, e.g.
+// "sha.abc123.date.20240501" has the fields sha=abc123 and date=20240501.
+// The zero value has no fields and is ready to use.
+type BuildFields struct {
+	pairs [][2]string
+}
+
+// ParseBuildFields parses build metadata following the key-value convention.
+// It returns an error if the number of identifiers is odd.
+func ParseBuildFields(build string) (*BuildFields, error) {
+	f := &BuildFields{}
+	if build == "" {
+		return f, nil
+	}
+	identifiers := strings.Split(build, ".")
+	if len(identifiers)%2 != 0 {
+		return nil, fmt.Errorf("build metadata %q has an odd number of identifiers", build)
+	}
+	for i := 0; i < len(identifiers); i += 2 {
+		f.pairs = append(f.pairs, [2]string{identifiers[i], identifiers[i+1]})
+	}
+	return f, nil
+}
+
+// Get returns the value of the first field with the key.
+func (f *BuildFields) Get(key string) (string, bool) {
+	for _, p := range f.pairs {
+		if p[0] == key {
+			return p[1], true
+		}
+	}
+	return "", false
+}
+
+// Set sets the value of a field, an existing field keeps its position. It returns f to chain calls.
+func (f *BuildFields) Set(key, value string) *BuildFields {
+	for i, p := range f.pairs {
+		if p[0] == key {
+			f.pairs[i][1] = value
+			return f
+		}
+	}
+	f.pairs = append(f.pairs, [2]string{key, value})
+	return f
+}
+
+// Build returns the build metadata of the fields or an error if a key or value is not a valid identifier.
+func (f *BuildFields) Build() (string, error) {
+	for _, p := range f.pairs {
+		for _, identifier := range p {
+			if err := validateIdentifier(identifier, false); err != nil {
+				return "", fmt.Errorf("invalid build field %s=%s: %w", p[0], p[1], err)
+			}
+		}
+	}
+	return f.String(), nil
+}
+
+// String returns the build metadata of the fields without validation.
+func (f *BuildFields) String() string {
+	identifiers := make([]string, 0, 2*len(f.pairs))
+	for _, p := range f.pairs {
+		identifiers = append(identifiers, p[0], p[1])
+	}
+	return strings.Join(identifiers, ".")
+}
+
+// BuildField returns the value of a field of the build metadata following the convention of BuildFields.
+// It returns false if the field is missing or the build metadata doesn't follow the convention.
+func (v *Version) BuildField(key string) (string, bool) {
+	f, err := ParseBuildFields(v.Build)
+	if err != nil {
+		return "", false
+	}
+	return f.Get(key)
+}
+
+// BuildFieldInt returns the value of a field of the build metadata as an integer, e.g. a CI build number.
+// It returns false if the field is missing or not an integer.
+func (v *Version) BuildFieldInt(key string) (int, bool) {
+	s, ok := v.BuildField(key)
+	if !ok {
+		return 0, false
+	}
+	n, err := strconv.Atoi(s)
+	return n, err == nil
+}
+
+// BuildFieldTime returns the value of a field of the build metadata as a time in UTC parsed with the layout, e.g.
+// "20060102" for a date. It returns false if the field is missing or doesn't match the layout.
+func (v *Version) BuildFieldTime(key, layout string) (time.Time, bool) {
+	s, ok := v.BuildField(key)
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(layout, s)
+	return t, err == nil
+}
@@ -0,0 +1,52 @@
+package semver_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/networkteam/semver"
+)
+
+func TestVersion_BuildField(t *testing.T) {
+	v := mustParse(t, "1.2.3+sha.abc123.date.20240501.run.42")
+
+	if sha, ok := v.BuildField("sha"); !ok || sha != "abc123" {
+		t.Errorf("Expected sha %q, got %q", "abc123", sha)
+	}
+	if run, ok := v.BuildFieldInt("run"); !ok || run != 42 {
+		t.Errorf("Expected run 42, got %d", run)
+	}
+	if _, ok := v.BuildFieldInt("sha"); ok {
+		t.Errorf("Expected sha not to be an integer")
+	}
+	if date, ok := v.BuildFieldTime("date", "20060102"); !ok || !date.Equal(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected date %v", date)
+	}
+	if _, ok := v.BuildField("branch"); ok {
+		t.Errorf("Expected missing field")
+	}
+	if _, ok := mustParse(t, "1.2.3+sha.abc123.dirty").BuildField("sha"); ok {
+		t.Errorf("Expected no fields for build metadata with an odd number of identifiers")
+	}
+}
+
+func TestBuildFields(t *testing.T) {
+	f, err := semver.ParseBuildFields("sha.abc123")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	build, err := f.Set("date", "20240501").Set("sha", "def456").Build()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if build != "sha.def456.date.20240501" {
+		t.Errorf("Expected %q, got %q", "sha.def456.date.20240501", build)
+	}
+
+	if _, err := (&semver.BuildFields{}).Set("branch", "feature/x").Build(); err == nil || err.Error() != "invalid build field branch=feature/x: character '/' is not allowed" {
+		t.Errorf("Unexpected error %v", err)
+	}
+	if _, err := semver.ParseBuildFields("a.b.c"); err == nil {
+		t.Errorf("Expected error for odd number of identifiers")
+	}
+}
@@ -0,0 +1,34 @@
+package semver
+
+import (
+	"context"
+	"fmt"
+)
+
+// cancelCheckInterval is the number of items after which bulk operations check the context.
+const cancelCheckInterval = 1024
+
+// checkContext returns the error of the context every cancelCheckInterval iterations, starting with the first.
+func checkContext(ctx context.Context, i int) error {
+	if i%cancelCheckInterval == 0 {
+		return ctx.Err()
+	}
+	return nil
+}
+
+// ParseAll parses all versions with ParseVersion. It returns the error of the first invalid version with its index,
+// or the error of the context if it is done before all versions were parsed.
+func ParseAll(ctx context.Context, versions []string) ([]*Version, error) {
+	result := make([]*Version, len(versions))
+	for i, s := range versions {
+		if err := checkContext(ctx, i); err != nil {
+			return nil, err
+		}
+		v, err := ParseVersion(s)
+		if err != nil {
+			return nil, fmt.Errorf("version %d %q: %w", i, s, err)
+		}
+		result[i] = v
+	}
+	return result, nil
+}
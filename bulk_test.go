@@ -0,0 +1,100 @@
+package semver_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestParseAll(t *testing.T) {
+	versions, err := semver.ParseAll(context.Background(), []string{"1.0.0", "2.0.0-rc.1"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := fmt.Sprint(versions); got != "[1.0.0 2.0.0-rc.1]" {
+		t.Errorf("Expected %s, got %s", "[1.0.0 2.0.0-rc.1]", got)
+	}
+
+	_, err = semver.ParseAll(context.Background(), []string{"1.0.0", "1.2"})
+	if err == nil || err.Error() != `version 1 "1.2": invalid version core: missing dot separator (at position 3)` {
+		t.Errorf("Expected error for invalid version, got %v", err)
+	}
+}
+
+func TestBulk_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	inputs := make([]string, 5000)
+	versions := make([]*semver.Version, 5000)
+	for i := range inputs {
+		inputs[i] = fmt.Sprintf("1.%d.0", len(inputs)-i)
+		versions[i] = &semver.Version{Major: 1, Minor: i}
+	}
+
+	if _, err := semver.ParseAll(ctx, inputs); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected ParseAll to return context.Canceled, got %v", err)
+	}
+	if err := semver.SortStringsLooseContext(ctx, inputs); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected SortStringsLooseContext to return context.Canceled, got %v", err)
+	}
+	if inputs[0] != "1.5000.0" {
+		t.Errorf("Expected strings to be unmodified, got %q first", inputs[0])
+	}
+	if _, err := semver.NewIndexContext(ctx, versions...); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected NewIndexContext to return context.Canceled, got %v", err)
+	}
+	if _, err := semver.NewVersionListContext(ctx, versions...); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected NewVersionListContext to return context.Canceled, got %v", err)
+	}
+}
+
+func TestBulk_Context(t *testing.T) {
+	versions := []*semver.Version{{Major: 2}, {Major: 1}}
+
+	idx, err := semver.NewIndexContext(context.Background(), versions...)
+	if err != nil || idx.Len() != 2 {
+		t.Errorf("Expected index with 2 versions, got %v", err)
+	}
+	l, err := semver.NewVersionListContext(context.Background(), versions...)
+	if err != nil || fmt.Sprint(l.Snapshot()) != "[1.0.0 2.0.0]" {
+		t.Errorf("Expected list [1.0.0 2.0.0], got %v", err)
+	}
+	s := []string{"2.0.0", "invalid", "1.0.0"}
+	if err := semver.SortStringsLooseContext(context.Background(), s); err != nil || fmt.Sprint(s) != "[1.0.0 2.0.0 invalid]" {
+		t.Errorf("Expected [1.0.0 2.0.0 invalid], got %v (%v)", s, err)
+	}
+}
+
+// cancelAfterContext is done after its error was checked the given number of times.
+type cancelAfterContext struct {
+	context.Context
+	checks int
+}
+
+func (c *cancelAfterContext) Err() error {
+	if c.checks == 0 {
+		return context.Canceled
+	}
+	c.checks--
+	return nil
+}
+
+func TestSortStringsLooseContext_CanceledWhileSorting(t *testing.T) {
+	inputs := make([]string, 5000)
+	for i := range inputs {
+		inputs[i] = fmt.Sprintf("1.%d.0", len(inputs)-i)
+	}
+
+	// The context is checked 5 times while parsing 5000 versions.
+	ctx := &cancelAfterContext{Context: context.Background(), checks: 5}
+	if err := semver.SortStringsLooseContext(ctx, inputs); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected SortStringsLooseContext to return context.Canceled, got %v", err)
+	}
+	if inputs[0] != "1.5000.0" || inputs[4999] != "1.1.0" {
+		t.Errorf("Expected strings to be unmodified, got %q first", inputs[0])
+	}
+}
@@ -0,0 +1,91 @@
+package semver
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// CanonicalJSON is the stable JSON object schema of a version for services exchanging version data:
+//
+//	{"major":1,"minor":2,"patch":3,"prerelease":[{"value":"rc","numeric":false},{"value":"1","numeric":true}],"build":["linux"]}
+//
+// Fields are always written in this order without whitespace, prerelease and build are empty arrays if not set.
+// The epoch extension is written as a leading "epoch" field only if it is not zero.
+type CanonicalJSON struct {
+	Epoch      int                   `json:"epoch,omitempty"`
+	Major      int                   `json:"major"`
+	Minor      int                   `json:"minor"`
+	Patch      int                   `json:"patch"`
+	PreRelease []CanonicalIdentifier `json:"prerelease"`
+	Build      []string              `json:"build"`
+}
+
+// CanonicalIdentifier is a pre-release identifier in CanonicalJSON.
+// Numeric identifiers are compared numerically, others lexically in ASCII sort order.
+type CanonicalIdentifier struct {
+	Value   string `json:"value"`
+	Numeric bool   `json:"numeric"`
+}
+
+// MarshalCanonicalJSON returns the version as CanonicalJSON. Versions with the same fields always marshal to the same
+// bytes, so payloads can be compared byte by byte.
+func MarshalCanonicalJSON(v *Version) ([]byte, error) {
+	c := CanonicalJSON{
+		Epoch:      v.Epoch,
+		Major:      v.Major,
+		Minor:      v.Minor,
+		Patch:      v.Patch,
+		PreRelease: []CanonicalIdentifier{},
+		Build:      []string{},
+	}
+	if v.PreRelease != "" {
+		for _, identifier := range strings.Split(v.PreRelease, ".") {
+			_, numeric := checkNumeric(identifier)
+			c.PreRelease = append(c.PreRelease, CanonicalIdentifier{Value: identifier, Numeric: numeric})
+		}
+	}
+	if v.Build != "" {
+		c.Build = strings.Split(v.Build, ".")
+	}
+	return json.Marshal(c)
+}
+
+// UnmarshalCanonicalJSON parses a version from CanonicalJSON and validates each part according to the spec like
+// FromParts, e.g. numeric pre-release identifiers must not have leading zeros. The numeric flags of pre-release identifiers are informational and not checked.
+func UnmarshalCanonicalJSON(data []byte) (*Version, error) {
+	var c CanonicalJSON
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, err
+	}
+
+	for _, part := range []struct {
+		name  string
+		value int
+	}{{"epoch", c.Epoch}, {"major version", c.Major}, {"minor version", c.Minor}, {"patch version", c.Patch}} {
+		if part.value < 0 {
+			return nil, fmt.Errorf("%s %d is negative", part.name, part.value)
+		}
+	}
+	identifiers := make([]string, len(c.PreRelease))
+	for i, identifier := range c.PreRelease {
+		if err := validateIdentifier(identifier.Value, true); err != nil {
+			return nil, fmt.Errorf("invalid pre-release identifier %q: %w", identifier.Value, err)
+		}
+		identifiers[i] = identifier.Value
+	}
+	for _, identifier := range c.Build {
+		if err := validateIdentifier(identifier, false); err != nil {
+			return nil, fmt.Errorf("invalid build identifier %q: %w", identifier, err)
+		}
+	}
+
+	return &Version{
+		Epoch:      c.Epoch,
+		Major:      c.Major,
+		Minor:      c.Minor,
+		Patch:      c.Patch,
+		PreRelease: strings.Join(identifiers, "."),
+		Build:      strings.Join(c.Build, "."),
+	}, nil
+}
@@ -0,0 +1,62 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestMarshalCanonicalJSON(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected string
+	}{
+		{"1.2.3", `{"major":1,"minor":2,"patch":3,"prerelease":[],"build":[]}`},
+		{"1.2.3-rc.1+linux.amd64", `{"major":1,"minor":2,"patch":3,"prerelease":[{"value":"rc","numeric":false},{"value":"1","numeric":true}],"build":["linux","amd64"]}`},
+		{"1.0.0-0A.1-2", `{"major":1,"minor":0,"patch":0,"prerelease":[{"value":"0A","numeric":false},{"value":"1-2","numeric":false}],"build":[]}`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			data, err := semver.MarshalCanonicalJSON(mustParse(t, test.version))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if string(data) != test.expected {
+				t.Errorf("Expected %s, got %s", test.expected, data)
+			}
+
+			v, err := semver.UnmarshalCanonicalJSON(data)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if v.String() != test.version {
+				t.Errorf("Expected %q after round trip, got %q", test.version, v)
+			}
+		})
+	}
+}
+
+func TestUnmarshalCanonicalJSON_Invalid(t *testing.T) {
+	tests := []struct {
+		data        string
+		expectedErr string
+	}{
+		{`{"major":1,"minor":2,"patch":3,"prerelease":[{"value":"rc_1"}],"build":[]}`, `invalid pre-release identifier "rc_1": character '_' is not allowed`},
+		{`{"major":1,"minor":0,"patch":0,"prerelease":[{"value":"alpha"},{"value":""},{"value":"01"}]}`, `invalid pre-release identifier "": identifier is empty`},
+		{`{"major":1,"minor":0,"patch":0,"prerelease":[{"value":"alpha"},{"value":"01"}]}`, `invalid pre-release identifier "01": leading zero is not allowed`},
+		{`{"major":1,"minor":0,"patch":0,"prerelease":[{"value":"rc.1"}]}`, `invalid pre-release identifier "rc.1": character '.' is not allowed`},
+		{`{"major":1,"minor":0,"patch":0,"build":["linux",""]}`, `invalid build identifier "": identifier is empty`},
+		{`{"major":-1,"minor":0,"patch":0}`, `major version -1 is negative`},
+		{`{"epoch":-1,"major":1,"minor":0,"patch":0}`, `epoch -1 is negative`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.data, func(t *testing.T) {
+			_, err := semver.UnmarshalCanonicalJSON([]byte(test.data))
+			if err == nil || err.Error() != test.expectedErr {
+				t.Errorf("Expected error %q, got %v", test.expectedErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+//go:build cexport
+
+// Command cexport exports the semver package with a C ABI for use from other languages:
+//
+//	go build -tags cexport -buildmode=c-shared -o libsemver.so ./cexport
+//
+// The build generates libsemver.h with these functions:
+//
+//	char* semver_parse(char* version)                            returns the version as a JSON object or {"error": "..."}
+//	int semver_compare(char* a, char* b, char** err)             returns -1, 0 or 1 by precedence
+//	int semver_satisfies(char* version, char* expr, char** err)  returns 1 if the version matches a semver.Expr, else 0
+//	void semver_free(char* s)                                    frees a string returned by the library
+//
+// On errors semver_compare and semver_satisfies return -2 and set *err to a message (if err is not NULL), which must
+// be freed with semver_free.
+package main
+
+// #include <stdlib.h>
+import "C"
+
+import (
+	"encoding/json"
+	"unsafe"
+
+	"github.com/networkteam/semver"
+)
+
+func main() {}
+
+const errorResult = -2
+
+//export semver_parse
+func semver_parse(version *C.char) *C.char {
+	return C.CString(parseJSON(C.GoString(version)))
+}
+
+//export semver_compare
+func semver_compare(a, b *C.char, errOut **C.char) C.int {
+	result, err := compare(C.GoString(a), C.GoString(b))
+	if err != nil {
+		setError(errOut, err)
+		return errorResult
+	}
+	return C.int(result)
+}
+
+//export semver_satisfies
+func semver_satisfies(version, expr *C.char, errOut **C.char) C.int {
+	ok, err := satisfies(C.GoString(version), C.GoString(expr))
+	if err != nil {
+		setError(errOut, err)
+		return errorResult
+	}
+	if ok {
+		return 1
+	}
+	return 0
+}
+
+//export semver_free
+func semver_free(s *C.char) {
+	C.free(unsafe.Pointer(s))
+}
+
+func setError(errOut **C.char, err error) {
+	if errOut != nil {
+		*errOut = C.CString(err.Error())
+	}
+}
+
+type parseResult struct {
+	Major      int    `json:"major"`
+	Minor      int    `json:"minor"`
+	Patch      int    `json:"patch"`
+	PreRelease string `json:"prerelease"`
+	Build      string `json:"build"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func parseJSON(version string) string {
+	var result any
+	v, err := semver.ParseVersionWithOptions(version, semver.ParseOptions{})
+	if err != nil {
+		result = errorResponse{Error: err.Error()}
+	} else {
+		result = parseResult{Major: v.Major, Minor: v.Minor, Patch: v.Patch, PreRelease: v.PreRelease, Build: v.Build}
+	}
+	data, _ := json.Marshal(result)
+	return string(data)
+}
+
+func compare(a, b string) (int, error) {
+	va, err := semver.ParseVersionWithOptions(a, semver.ParseOptions{})
+	if err != nil {
+		return 0, err
+	}
+	vb, err := semver.ParseVersionWithOptions(b, semver.ParseOptions{})
+	if err != nil {
+		return 0, err
+	}
+	return va.Compare(vb), nil
+}
+
+func satisfies(version, expr string) (bool, error) {
+	v, err := semver.ParseVersionWithOptions(version, semver.ParseOptions{})
+	if err != nil {
+		return false, err
+	}
+	e, err := semver.CompileExpr(expr)
+	if err != nil {
+		return false, err
+	}
+	return e.Match(v), nil
+}
@@ -0,0 +1,47 @@
+//go:build cexport
+
+package main
+
+import (
+	"testing"
+)
+
+func TestParseJSON(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected string
+	}{
+		{"1.2.3-rc.1+build", `{"major":1,"minor":2,"patch":3,"prerelease":"rc.1","build":"build"}`},
+		{"1.2", `{"error":"invalid version core: missing dot separator (at position 3)"}`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			if result := parseJSON(test.version); result != test.expected {
+				t.Errorf("Expected %s, got %s", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	result, err := compare("1.0.0-rc.1", "1.0.0")
+	if err != nil || result != -1 {
+		t.Errorf("Expected -1, got %d (%v)", result, err)
+	}
+
+	if _, err := compare("1.0.0", "x"); err == nil {
+		t.Errorf("Expected error for invalid version")
+	}
+}
+
+func TestSatisfies(t *testing.T) {
+	ok, err := satisfies("2.1.0", `major >= 2 && prerelease == ""`)
+	if err != nil || !ok {
+		t.Errorf("Expected true, got %v (%v)", ok, err)
+	}
+
+	if _, err := satisfies("2.1.0", `major >=`); err == nil {
+		t.Errorf("Expected error for invalid expression")
+	}
+}
@@ -0,0 +1,212 @@
+package semver
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ChangeType is a category of changes in a release section of a changelog in the Keep a Changelog format
+// (https://keepachangelog.com/).
+type ChangeType string
+
+// Change types of Keep a Changelog.
+const (
+	Added      ChangeType = "Added"
+	Changed    ChangeType = "Changed"
+	Deprecated ChangeType = "Deprecated"
+	Removed    ChangeType = "Removed"
+	Fixed      ChangeType = "Fixed"
+	Security   ChangeType = "Security"
+)
+
+var changeTypes = []ChangeType{Added, Changed, Deprecated, Removed, Fixed, Security}
+
+// ReleaseNotes describes a release for a changelog in the Keep a Changelog format.
+type ReleaseNotes struct {
+	// Previous is the version of the previous release, it is optional.
+	Previous *Version
+	Version  *Version
+	// Date of the release, it is omitted if zero.
+	Date time.Time
+	// Changes are the entries of the release by type.
+	Changes map[ChangeType][]string
+	// CompareURL is a template for the link to the changes since the previous release, where {previous} and {version}
+	// are replaced by the versions (e.g. "https://github.com/networkteam/semver/compare/v{previous}...v{version}").
+	// The link is omitted if CompareURL or Previous is empty.
+	CompareURL string
+}
+
+// Markdown returns the release section in Markdown.
+// Entries of the standard change types are written in the order of Keep a Changelog, other types follow in
+// lexical order.
+func (r ReleaseNotes) Markdown() string {
+	var sb strings.Builder
+
+	sb.WriteString("## [" + r.Version.String() + "]")
+	if !r.Date.IsZero() {
+		sb.WriteString(" - " + r.Date.Format("2006-01-02"))
+	}
+	sb.WriteString("\n")
+
+	for _, changeType := range r.changeTypes() {
+		entries := r.Changes[changeType]
+		if len(entries) == 0 {
+			continue
+		}
+		sb.WriteString("\n### " + string(changeType) + "\n\n")
+		for _, entry := range entries {
+			sb.WriteString("- " + entry + "\n")
+		}
+	}
+
+	if r.CompareURL != "" && r.Previous != nil {
+		link := strings.NewReplacer("{previous}", r.Previous.String(), "{version}", r.Version.String()).Replace(r.CompareURL)
+		sb.WriteString("\n[" + r.Version.String() + "]: " + link + "\n")
+	}
+
+	return sb.String()
+}
+
+func (r ReleaseNotes) changeTypes() []ChangeType {
+	result := append([]ChangeType{}, changeTypes...)
+
+	var custom []ChangeType
+	for changeType := range r.Changes {
+		if !isStandardChangeType(changeType) {
+			custom = append(custom, changeType)
+		}
+	}
+	sort.Slice(custom, func(i, j int) bool { return custom[i] < custom[j] })
+
+	return append(result, custom...)
+}
+
+func isStandardChangeType(changeType ChangeType) bool {
+	for _, t := range changeTypes {
+		if t == changeType {
+			return true
+		}
+	}
+	return false
+}
+
+// Changelog is a parsed changelog in the Keep a Changelog format.
+type Changelog struct {
+	// Releases in the order of the changelog (usually the latest first). Unreleased changes are not included.
+	Releases []ChangelogRelease
+}
+
+// ChangelogRelease is a release section of a changelog.
+type ChangelogRelease struct {
+	Version *Version
+	// Date of the release, it is zero if the heading has no date.
+	Date time.Time
+	// Yanked is true if the release is marked with [YANKED].
+	Yanked bool
+	// Changes are the entries of the release by type.
+	Changes map[ChangeType][]string
+}
+
+// ParseChangelog parses a changelog in the Keep a Changelog format.
+// Release headings must be of the form "## [1.0.0] - 2017-06-20", brackets, date and a trailing "[YANKED]" are
+// optional. It returns an error if a release heading contains an invalid version or date.
+func ParseChangelog(r io.Reader) (*Changelog, error) {
+	changelog := &Changelog{}
+
+	var release *ChangelogRelease
+	var changeType ChangeType
+	lineNo := 0
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimRight(scanner.Text(), " \t")
+
+		switch {
+		case strings.HasPrefix(line, "## "):
+			if release != nil {
+				changelog.Releases = append(changelog.Releases, *release)
+			}
+			changeType = ""
+
+			var err error
+			release, err = parseReleaseHeading(strings.TrimSpace(line[3:]))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNo, err)
+			}
+		case strings.HasPrefix(line, "### "):
+			changeType = ChangeType(strings.TrimSpace(line[4:]))
+		case release != nil && changeType != "" && (strings.HasPrefix(line, "- ") || strings.HasPrefix(line, "* ")):
+			release.Changes[changeType] = append(release.Changes[changeType], strings.TrimSpace(line[2:]))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if release != nil {
+		changelog.Releases = append(changelog.Releases, *release)
+	}
+
+	return changelog, nil
+}
+
+// parseReleaseHeading parses the text of a release heading, it returns nil for the unreleased section.
+func parseReleaseHeading(heading string) (*ChangelogRelease, error) {
+	var versionStr string
+	if strings.HasPrefix(heading, "[") {
+		end := strings.IndexByte(heading, ']')
+		if end < 0 {
+			return nil, fmt.Errorf("missing closing bracket in heading %q", heading)
+		}
+		versionStr, heading = heading[1:end], heading[end+1:]
+	} else {
+		versionStr, heading, _ = strings.Cut(heading, " ")
+	}
+
+	if strings.EqualFold(versionStr, "Unreleased") {
+		return nil, nil
+	}
+
+	v, err := ParseVersionWithOptions(versionStr, ParseOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("invalid version %q: %w", versionStr, err)
+	}
+	release := &ChangelogRelease{Version: v, Changes: make(map[ChangeType][]string)}
+
+	heading = strings.TrimSpace(heading)
+	if strings.HasSuffix(heading, "[YANKED]") {
+		release.Yanked = true
+		heading = strings.TrimSpace(strings.TrimSuffix(heading, "[YANKED]"))
+	}
+	if dateStr, found := strings.CutPrefix(heading, "- "); found {
+		release.Date, err = time.Parse("2006-01-02", strings.TrimSpace(dateStr))
+		if err != nil {
+			return nil, fmt.Errorf("invalid date %q: %w", dateStr, err)
+		}
+	}
+
+	return release, nil
+}
+
+// Versions returns the versions of all releases in the order of the changelog.
+func (c *Changelog) Versions() []*Version {
+	versions := make([]*Version, len(c.Releases))
+	for i, release := range c.Releases {
+		versions[i] = release.Version
+	}
+	return versions
+}
+
+// Release returns the release of the given version (including the build metadata), if the changelog contains it.
+func (c *Changelog) Release(v *Version) (*ChangelogRelease, bool) {
+	for i, release := range c.Releases {
+		if release.Version.Equals(v) && release.Version.Build == v.Build {
+			return &c.Releases[i], true
+		}
+	}
+	return nil, false
+}
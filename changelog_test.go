@@ -0,0 +1,153 @@
+package semver_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/networkteam/semver"
+)
+
+func TestReleaseNotes_Markdown(t *testing.T) {
+	notes := semver.ReleaseNotes{
+		Previous: mustParse(t, "1.4.2"),
+		Version:  mustParse(t, "1.5.0"),
+		Date:     time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		Changes: map[semver.ChangeType][]string{
+			semver.Fixed:    {"Parsing of build metadata"},
+			semver.Added:    {"Compare function", "Expression language"},
+			"Documentation": {"Examples for patterns"},
+		},
+		CompareURL: "https://github.com/networkteam/semver/compare/v{previous}...v{version}",
+	}
+
+	expected := `## [1.5.0] - 2024-05-01
+
+### Added
+
+- Compare function
+- Expression language
+
+### Fixed
+
+- Parsing of build metadata
+
+### Documentation
+
+- Examples for patterns
+
+[1.5.0]: https://github.com/networkteam/semver/compare/v1.4.2...v1.5.0
+`
+	if result := notes.Markdown(); result != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, result)
+	}
+}
+
+func TestReleaseNotes_Markdown_Minimal(t *testing.T) {
+	notes := semver.ReleaseNotes{
+		Version: mustParse(t, "0.1.0"),
+		Changes: map[semver.ChangeType][]string{
+			semver.Added: {"Initial release"},
+		},
+		CompareURL: "https://example.com/{previous}/{version}",
+	}
+
+	expected := `## [0.1.0]
+
+### Added
+
+- Initial release
+`
+	if result := notes.Markdown(); result != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, result)
+	}
+}
+
+func TestParseChangelog(t *testing.T) {
+	input := `# Changelog
+
+All notable changes to this project will be documented in this file.
+
+## [Unreleased]
+
+### Added
+
+- Something new
+
+## [1.1.0] - 2024-05-01
+
+### Added
+
+- Compare function
+* Expression language
+
+### Fixed
+
+- Parsing of build metadata
+
+## 1.0.1 - 2024-03-12
+
+## [1.0.0] - 2024-01-02 [YANKED]
+
+### Removed
+
+- Legacy API
+
+[unreleased]: https://github.com/networkteam/semver/compare/v1.1.0...HEAD
+[1.1.0]: https://github.com/networkteam/semver/compare/v1.0.1...v1.1.0
+`
+
+	changelog, err := semver.ParseChangelog(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if result := fmt.Sprint(changelog.Versions()); result != "[1.1.0 1.0.1 1.0.0]" {
+		t.Errorf("Expected versions [1.1.0 1.0.1 1.0.0], got %s", result)
+	}
+
+	release, ok := changelog.Release(mustParse(t, "1.1.0"))
+	if !ok {
+		t.Fatalf("Expected release 1.1.0")
+	}
+	if !release.Date.Equal(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected date 2024-05-01, got %s", release.Date)
+	}
+	if result := fmt.Sprint(release.Changes[semver.Added]); result != "[Compare function Expression language]" {
+		t.Errorf("Expected added entries, got %s", result)
+	}
+	if result := fmt.Sprint(release.Changes[semver.Fixed]); result != "[Parsing of build metadata]" {
+		t.Errorf("Expected fixed entries, got %s", result)
+	}
+
+	if !changelog.Releases[2].Yanked {
+		t.Errorf("Expected release 1.0.0 to be yanked")
+	}
+	if _, ok := changelog.Release(mustParse(t, "1.2.0")); ok {
+		t.Errorf("Expected no release 1.2.0")
+	}
+}
+
+func TestParseChangelog_Errors(t *testing.T) {
+	tests := []struct {
+		input       string
+		expectedErr string
+	}{
+		{"# Changelog\n\n## [1.0] - 2024-01-02\n", `line 3: invalid version "1.0": invalid version core: missing dot separator (at position 3)`},
+		{"## [1.0.0] - 2024-13-02\n", `line 1: invalid date "2024-13-02": parsing time "2024-13-02": month out of range`},
+		{"## [1.0.0 - 2024-01-02\n", `line 1: missing closing bracket in heading "[1.0.0 - 2024-01-02"`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expectedErr, func(t *testing.T) {
+			_, err := semver.ParseChangelog(strings.NewReader(test.input))
+			if err == nil {
+				t.Fatalf("Expected error %q, got nil", test.expectedErr)
+			}
+			if err.Error() != test.expectedErr {
+				t.Errorf("Expected error %q, got %q", test.expectedErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package semver
+
+import (
+	"context"
+)
+
+type contextKey struct{}
+
+// NewContext returns a new context that carries the version.
+func NewContext(ctx context.Context, v *Version) context.Context {
+	return context.WithValue(ctx, contextKey{}, v)
+}
+
+// FromContext returns the version stored in the context by NewContext, if any.
+func FromContext(ctx context.Context) (*Version, bool) {
+	v, ok := ctx.Value(contextKey{}).(*Version)
+	return v, ok
+}
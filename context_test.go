@@ -0,0 +1,27 @@
+package semver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestContext(t *testing.T) {
+	ctx := context.Background()
+
+	if _, ok := semver.FromContext(ctx); ok {
+		t.Errorf("Expected no version in empty context")
+	}
+
+	v := mustParse(t, "1.2.3")
+	ctx = semver.NewContext(ctx, v)
+
+	got, ok := semver.FromContext(ctx)
+	if !ok {
+		t.Fatalf("Expected version in context")
+	}
+	if got != v {
+		t.Errorf("Expected version %s, got %s", v, got)
+	}
+}
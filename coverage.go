@@ -0,0 +1,51 @@
+package semver
+
+// Coverage is the result of AnalyzeCoverage.
+type Coverage struct {
+	// Matched are the matched versions in the order of the analyzed versions.
+	Matched []*Version
+	// MatchedEOL are the matched versions that have reached their end of life.
+	MatchedEOL []*Version
+}
+
+// Dead determines if the matcher did not match any version.
+func (c Coverage) Dead() bool {
+	return len(c.Matched) == 0
+}
+
+// SpansEOL determines if the matcher matched versions that have reached their end of life.
+func (c Coverage) SpansEOL() bool {
+	return len(c.MatchedEOL) > 0
+}
+
+// AnalyzeCoverage reports which of the published versions are matched by m, e.g. to lint an Expr or Pattern supplied
+// by a user. The optional isEOL function tells if a version has reached its end of life.
+func AnalyzeCoverage(m Matcher, versions []*Version, isEOL func(*Version) bool) Coverage {
+	var c Coverage
+	for _, v := range versions {
+		if !m.Match(v) {
+			continue
+		}
+		c.Matched = append(c.Matched, v)
+		if isEOL != nil && isEOL(v) {
+			c.MatchedEOL = append(c.MatchedEOL, v)
+		}
+	}
+	return c
+}
+
+// Gaps returns the versions of the universe that are not matched by any of the matchers, e.g. to validate that a
+// support matrix covers every released version.
+func Gaps(matchers []Matcher, universe []*Version) []*Version {
+	var gaps []*Version
+outer:
+	for _, v := range universe {
+		for _, m := range matchers {
+			if m.Match(v) {
+				continue outer
+			}
+		}
+		gaps = append(gaps, v)
+	}
+	return gaps
+}
@@ -0,0 +1,74 @@
+package semver_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestAnalyzeCoverage(t *testing.T) {
+	var versions []*semver.Version
+	for _, s := range []string{"1.0.0", "1.1.0", "2.0.0", "2.1.0", "3.0.0-rc.1"} {
+		versions = append(versions, mustParse(t, s))
+	}
+	isEOL := func(v *semver.Version) bool {
+		return v.Major < 2
+	}
+
+	tests := []struct {
+		pattern            string
+		expectedMatched    string
+		expectedMatchedEOL string
+		expectedDead       bool
+		expectedSpansEOL   bool
+	}{
+		{"2.*.*", "[2.0.0 2.1.0]", "[]", false, false},
+		{"*.1.*", "[1.1.0 2.1.0]", "[1.1.0]", false, true},
+		{"4.*.*", "[]", "[]", true, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.pattern, func(t *testing.T) {
+			p, err := semver.CompilePattern(test.pattern)
+			if err != nil {
+				t.Fatalf("Error compiling pattern: %v", err)
+			}
+
+			c := semver.AnalyzeCoverage(p, versions, isEOL)
+			if got := versionStrings(c.Matched); fmt.Sprint(got) != test.expectedMatched {
+				t.Errorf("Expected matched %v, got %v", test.expectedMatched, got)
+			}
+			if got := versionStrings(c.MatchedEOL); fmt.Sprint(got) != test.expectedMatchedEOL {
+				t.Errorf("Expected matched EOL %v, got %v", test.expectedMatchedEOL, got)
+			}
+			if c.Dead() != test.expectedDead {
+				t.Errorf("Expected dead %v, got %v", test.expectedDead, c.Dead())
+			}
+			if c.SpansEOL() != test.expectedSpansEOL {
+				t.Errorf("Expected spans EOL %v, got %v", test.expectedSpansEOL, c.SpansEOL())
+			}
+		})
+	}
+}
+
+func TestGaps(t *testing.T) {
+	var universe []*semver.Version
+	for _, s := range []string{"1.0.0", "1.1.0", "2.0.0", "2.1.0", "3.0.0"} {
+		universe = append(universe, mustParse(t, s))
+	}
+
+	var matchers []semver.Matcher
+	for _, s := range []string{"1.*.*", "2.0.*"} {
+		p, err := semver.CompilePattern(s)
+		if err != nil {
+			t.Fatalf("Error compiling pattern: %v", err)
+		}
+		matchers = append(matchers, p)
+	}
+
+	expected := "[2.1.0 3.0.0]"
+	if got := versionStrings(semver.Gaps(matchers, universe)); fmt.Sprint(got) != expected {
+		t.Errorf("Expected gaps %s, got %v", expected, got)
+	}
+}
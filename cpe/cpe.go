@@ -0,0 +1,130 @@
+// Package cpe evaluates CPE match criteria, like the configurations of the National Vulnerability Database (NVD),
+// against versions.
+//
+// Versions of CPE names and range bounds are parsed with semver.ParsePartial, so "1.2" is the version 1.2.0.
+package cpe
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/networkteam/semver"
+)
+
+// Name is a parsed CPE 2.3 formatted string, e.g. "cpe:2.3:a:vendor:product:1.2.3:*:*:*:*:*:*:*".
+// Only the attributes up to the update are kept.
+type Name struct {
+	Part    string
+	Vendor  string
+	Product string
+	// Version is "*" for any and "-" for not applicable.
+	Version string
+	Update  string
+}
+
+// ParseName parses a CPE 2.3 formatted string.
+func ParseName(s string) (*Name, error) {
+	if !strings.HasPrefix(s, "cpe:2.3:") {
+		return nil, fmt.Errorf("invalid cpe %q: expected cpe:2.3 prefix", s)
+	}
+	attrs := splitAttributes(s[len("cpe:2.3:"):])
+	if len(attrs) != 11 {
+		return nil, fmt.Errorf("invalid cpe %q: expected 11 attributes, got %d", s, len(attrs))
+	}
+	return &Name{Part: attrs[0], Vendor: attrs[1], Product: attrs[2], Version: attrs[3], Update: attrs[4]}, nil
+}
+
+// splitAttributes splits at colons that are not escaped with a backslash and removes the escaping.
+func splitAttributes(s string) []string {
+	var attrs []string
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\' && i+1 < len(s):
+			i++
+			sb.WriteByte(s[i])
+		case c == ':':
+			attrs = append(attrs, sb.String())
+			sb.Reset()
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return append(attrs, sb.String())
+}
+
+// Match is a CPE match criterion in the format of the NVD API.
+type Match struct {
+	Vulnerable            bool   `json:"vulnerable"`
+	Criteria              string `json:"criteria"`
+	VersionStartIncluding string `json:"versionStartIncluding,omitempty"`
+	VersionStartExcluding string `json:"versionStartExcluding,omitempty"`
+	VersionEndIncluding   string `json:"versionEndIncluding,omitempty"`
+	VersionEndExcluding   string `json:"versionEndExcluding,omitempty"`
+}
+
+// Range returns the versions matched by the criterion. Without range bounds, the version of the criteria is used:
+// "*" matches any version and a concrete version only itself.
+// It returns an error if a version is invalid or the version of the criteria is not applicable ("-").
+func (m Match) Range() (semver.Range, error) {
+	var r semver.Range
+	bounds := []struct {
+		value     string
+		bound     **semver.Version
+		inclusive *bool
+		include   bool
+	}{
+		{m.VersionStartIncluding, &r.Lower, &r.LowerInclusive, true},
+		{m.VersionStartExcluding, &r.Lower, &r.LowerInclusive, false},
+		{m.VersionEndIncluding, &r.Upper, &r.UpperInclusive, true},
+		{m.VersionEndExcluding, &r.Upper, &r.UpperInclusive, false},
+	}
+	hasBounds := false
+	for _, b := range bounds {
+		if b.value == "" {
+			continue
+		}
+		v, err := parseVersion(b.value)
+		if err != nil {
+			return semver.Range{}, err
+		}
+		*b.bound, *b.inclusive = v, b.include
+		hasBounds = true
+	}
+	if hasBounds {
+		return r, nil
+	}
+
+	name, err := ParseName(m.Criteria)
+	if err != nil {
+		return semver.Range{}, err
+	}
+	switch name.Version {
+	case "*", "":
+		return r, nil
+	case "-":
+		return semver.Range{}, fmt.Errorf("version of cpe %q is not applicable", m.Criteria)
+	}
+	v, err := parseVersion(name.Version)
+	if err != nil {
+		return semver.Range{}, err
+	}
+	return semver.Range{Lower: v, LowerInclusive: true, Upper: v, UpperInclusive: true}, nil
+}
+
+// MatchVersion determines if the version is matched by the criterion, see Range.
+func (m Match) MatchVersion(v *semver.Version) (bool, error) {
+	r, err := m.Range()
+	if err != nil {
+		return false, err
+	}
+	return r.Match(v), nil
+}
+
+func parseVersion(s string) (*semver.Version, error) {
+	pv, err := semver.ParsePartial(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version %q: %w", s, err)
+	}
+	return pv.Version(), nil
+}
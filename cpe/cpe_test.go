@@ -0,0 +1,68 @@
+package cpe_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/cpe"
+)
+
+func TestParseName(t *testing.T) {
+	name, err := cpe.ParseName(`cpe:2.3:a:example:web\:server:2.4.1:rc1:*:*:*:*:*:*`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if name.Part != "a" || name.Vendor != "example" || name.Product != "web:server" || name.Version != "2.4.1" || name.Update != "rc1" {
+		t.Errorf("Unexpected name %+v", name)
+	}
+
+	if _, err := cpe.ParseName("cpe:/a:example:server:2.4.1"); err == nil {
+		t.Errorf("Expected error for CPE 2.2 URI")
+	}
+}
+
+func TestMatch_MatchVersion(t *testing.T) {
+	tests := []struct {
+		match       string
+		version     string
+		expected    bool
+		expectedErr string
+	}{
+		{`{"criteria": "cpe:2.3:a:example:server:*:*:*:*:*:*:*:*", "versionStartIncluding": "2.0", "versionEndExcluding": "2.4.1"}`, "2.0.0", true, ""},
+		{`{"criteria": "cpe:2.3:a:example:server:*:*:*:*:*:*:*:*", "versionStartIncluding": "2.0", "versionEndExcluding": "2.4.1"}`, "2.4.1", false, ""},
+		{`{"criteria": "cpe:2.3:a:example:server:*:*:*:*:*:*:*:*", "versionStartExcluding": "1.0.0", "versionEndIncluding": "1.5"}`, "1.5.0", true, ""},
+		{`{"criteria": "cpe:2.3:a:example:server:*:*:*:*:*:*:*:*", "versionStartExcluding": "1.0.0"}`, "1.0.0", false, ""},
+		{`{"criteria": "cpe:2.3:a:example:server:*:*:*:*:*:*:*:*"}`, "9.9.9", true, ""},
+		{`{"criteria": "cpe:2.3:a:example:server:1.2.3:*:*:*:*:*:*:*"}`, "1.2.3", true, ""},
+		{`{"criteria": "cpe:2.3:a:example:server:1.2.3:*:*:*:*:*:*:*"}`, "1.2.4", false, ""},
+		{`{"criteria": "cpe:2.3:a:example:server:-:*:*:*:*:*:*:*"}`, "1.2.4", false, `version of cpe "cpe:2.3:a:example:server:-:*:*:*:*:*:*:*" is not applicable`},
+		{`{"criteria": "cpe:2.3:a:example:server:*:*:*:*:*:*:*:*", "versionEndExcluding": "2.4.1a"}`, "1.0.0", false, `invalid version "2.4.1a": unexpected trailing characters: "a" (at position 5)`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.match+" "+test.version, func(t *testing.T) {
+			var m cpe.Match
+			if err := json.Unmarshal([]byte(test.match), &m); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			v, err := semver.ParseVersion(test.version)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			matched, err := m.MatchVersion(v)
+			if err != nil {
+				if err.Error() != test.expectedErr {
+					t.Errorf("Expected error %q, got %q", test.expectedErr, err)
+				}
+				return
+			}
+			if test.expectedErr != "" {
+				t.Fatalf("Expected error %q, got nil", test.expectedErr)
+			}
+			if matched != test.expected {
+				t.Errorf("Expected %v, got %v", test.expected, matched)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package semver
+
+import (
+	"sync/atomic"
+)
+
+var defaultParseOptions atomic.Pointer[ParseOptions]
+
+// SetDefaultParseOptions sets the options for the whole process, e.g. to allow a "v" prefix once instead of passing
+// options at every call site. It is safe for concurrent use, but should usually be called once during initialization.
+//
+// The defaults only apply to the direct parse functions ParseVersion, ParseAll and ParseFromReader and to parsers
+// created with NewParser. All other APIs that parse versions internally (e.g. ParsePartial, Normalize, CompileExpr,
+// Layout.Parse, the subpackages and version 2 of the package) and ParseVersionWithOptions ignore them, so their
+// behavior does not depend on global state.
+func SetDefaultParseOptions(opts ParseOptions) {
+	defaultParseOptions.Store(&opts)
+}
+
+// DefaultParseOptions returns the options set with SetDefaultParseOptions, the zero value by default.
+func DefaultParseOptions() ParseOptions {
+	if opts := defaultParseOptions.Load(); opts != nil {
+		return *opts
+	}
+	return ParseOptions{}
+}
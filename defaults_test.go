@@ -0,0 +1,70 @@
+package semver_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestSetDefaultParseOptions(t *testing.T) {
+	t.Cleanup(func() { semver.SetDefaultParseOptions(semver.ParseOptions{}) })
+
+	if _, err := semver.ParseVersion("v1.2.3"); err == nil {
+		t.Fatalf("Expected error for v prefix without options")
+	}
+
+	semver.SetDefaultParseOptions(semver.ParseOptions{AllowVPrefix: true, Epoch: true})
+	for input, expected := range map[string]string{"v1.2.3": "1.2.3", "V1.2.3-rc.1": "1.2.3-rc.1", "v2:1.0.0": "2:1.0.0", "1.2.3": "1.2.3"} {
+		v, err := semver.ParseVersion(input)
+		if err != nil {
+			t.Errorf("Unexpected error for %q: %v", input, err)
+			continue
+		}
+		if v.String() != expected {
+			t.Errorf("Expected %q, got %q", expected, v)
+		}
+	}
+	if _, err := semver.ParseVersion("vv1.2.3"); err == nil || err.Error() != "invalid version core: major: expected positive digit, got v (at position 1)" {
+		t.Errorf("Expected error for double v prefix, got %v", err)
+	}
+	if _, err := semver.ParseVersionWithOptions("v1.2.3", semver.ParseOptions{}); err == nil {
+		t.Errorf("Expected explicit options to ignore the defaults")
+	}
+}
+
+func TestSetDefaultParseOptions_Concurrent(t *testing.T) {
+	t.Cleanup(func() { semver.SetDefaultParseOptions(semver.ParseOptions{}) })
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			semver.SetDefaultParseOptions(semver.ParseOptions{AllowVPrefix: true})
+		}()
+		go func() {
+			defer wg.Done()
+			_, _ = semver.ParseVersion("v1.2.3")
+		}()
+	}
+	wg.Wait()
+}
+
+func TestSetDefaultParseOptions_InternalParsing(t *testing.T) {
+	semver.SetDefaultParseOptions(semver.ParseOptions{AllowVPrefix: true, Epoch: true})
+	t.Cleanup(func() { semver.SetDefaultParseOptions(semver.ParseOptions{}) })
+
+	if _, err := semver.Normalize("v1.2.3"); err == nil {
+		t.Errorf("Expected Normalize to ignore the defaults")
+	}
+	if _, err := semver.ParsePartial("1:1.2.3"); err == nil {
+		t.Errorf("Expected ParsePartial to ignore the defaults")
+	}
+	if _, err := semver.CompileExpr(`version >= "v1.0.0"`); err == nil {
+		t.Errorf("Expected CompileExpr to ignore the defaults")
+	}
+	if result := semver.CompareLoose("v1.0.0", "2.0.0"); result != 1 {
+		t.Errorf("Expected CompareLoose to order v1.0.0 as invalid version, got %d", result)
+	}
+}
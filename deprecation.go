@@ -0,0 +1,64 @@
+package semver
+
+import (
+	"fmt"
+)
+
+// Deprecation announces that a feature is deprecated since a version and (optionally) removed in a later version.
+// It is meant as a single source of truth for documentation generators and runtime warnings.
+type Deprecation struct {
+	Feature string
+	// Since is the first version in which the feature is deprecated.
+	Since *Version
+	// RemovedIn is the optional first version without the feature.
+	RemovedIn *Version
+	// Message is an optional hint for users, e.g. which feature to use instead.
+	Message string
+}
+
+// FeatureStatus is the status of a deprecated feature in a version.
+type FeatureStatus int
+
+const (
+	// StatusActive means the feature is available and not deprecated.
+	StatusActive FeatureStatus = iota
+	// StatusDeprecated means the feature is available but deprecated.
+	StatusDeprecated
+	// StatusRemoved means the feature is not available anymore.
+	StatusRemoved
+)
+
+func (s FeatureStatus) String() string {
+	switch s {
+	case StatusDeprecated:
+		return "deprecated"
+	case StatusRemoved:
+		return "removed"
+	default:
+		return "active"
+	}
+}
+
+// StatusAt returns the status of the feature in version v, compared by precedence.
+func (d Deprecation) StatusAt(v *Version) FeatureStatus {
+	switch {
+	case d.RemovedIn != nil && v.AtLeast(d.RemovedIn):
+		return StatusRemoved
+	case d.Since != nil && v.AtLeast(d.Since):
+		return StatusDeprecated
+	default:
+		return StatusActive
+	}
+}
+
+// String returns the announcement, e.g. "feature X is deprecated since 2.3.0 and will be removed in 3.0.0: use Y".
+func (d Deprecation) String() string {
+	s := fmt.Sprintf("feature %s is deprecated since %s", d.Feature, d.Since)
+	if d.RemovedIn != nil {
+		s += fmt.Sprintf(" and will be removed in %s", d.RemovedIn)
+	}
+	if d.Message != "" {
+		s += ": " + d.Message
+	}
+	return s
+}
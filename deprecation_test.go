@@ -0,0 +1,50 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestDeprecation_StatusAt(t *testing.T) {
+	d := semver.Deprecation{
+		Feature:   "legacy-auth",
+		Since:     mustParse(t, "2.3.0"),
+		RemovedIn: mustParse(t, "3.0.0"),
+	}
+
+	tests := []struct {
+		version  string
+		expected semver.FeatureStatus
+	}{
+		{"2.2.9", semver.StatusActive},
+		{"2.3.0-rc.1", semver.StatusActive},
+		{"2.3.0", semver.StatusDeprecated},
+		{"2.9.0", semver.StatusDeprecated},
+		{"3.0.0-beta.1", semver.StatusDeprecated},
+		{"3.0.0", semver.StatusRemoved},
+		{"4.1.0", semver.StatusRemoved},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			if status := d.StatusAt(mustParse(t, test.version)); status != test.expected {
+				t.Errorf("Expected status %v, got %v", test.expected, status)
+			}
+		})
+	}
+}
+
+func TestDeprecation_String(t *testing.T) {
+	d := semver.Deprecation{
+		Feature:   "legacy-auth",
+		Since:     mustParse(t, "2.3.0"),
+		RemovedIn: mustParse(t, "3.0.0"),
+		Message:   "use tokens instead",
+	}
+
+	expected := "feature legacy-auth is deprecated since 2.3.0 and will be removed in 3.0.0: use tokens instead"
+	if s := d.String(); s != expected {
+		t.Errorf("Expected %q, got %q", expected, s)
+	}
+}
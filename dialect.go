@@ -0,0 +1,81 @@
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Dialect is the syntax of version requirements of a package manager.
+type Dialect int
+
+const (
+	// DialectNPM is the range syntax of npm, e.g. ">=1.0.0 <2.0.0".
+	DialectNPM Dialect = iota + 1
+	// DialectCargo is the requirement syntax of Cargo, e.g. ">=1.0.0, <2.0.0".
+	DialectCargo
+	// DialectComposer is the constraint syntax of Composer, e.g. ">=1.0.0 <2.0.0".
+	DialectComposer
+)
+
+func (d Dialect) String() string {
+	switch d {
+	case DialectNPM:
+		return "npm"
+	case DialectCargo:
+		return "cargo"
+	case DialectComposer:
+		return "composer"
+	default:
+		return "unknown"
+	}
+}
+
+// StringDialect renders the range in the syntax of a package manager, e.g. to generate manifests. It returns an error
+// if the range is empty or a bound has an epoch.
+//
+// The package managers exclude pre-releases that are not explicitly requested, so the rendered requirement only
+// matches the releases of the range and pre-releases of the same version as a bound with a pre-release.
+func (r Range) StringDialect(d Dialect) (string, error) {
+	if r.IsEmpty() {
+		return "", errors.New("range is empty")
+	}
+	for _, bound := range []*Version{r.Lower, r.Upper} {
+		if bound != nil && bound.Epoch != 0 {
+			return "", fmt.Errorf("version %s has an epoch, which %s can't express", bound, d)
+		}
+	}
+
+	var separator, exact string
+	switch d {
+	case DialectNPM, DialectComposer:
+		separator = " "
+	case DialectCargo:
+		separator, exact = ", ", "="
+	default:
+		return "", fmt.Errorf("unknown dialect %d", d)
+	}
+
+	if r.Lower != nil && r.Upper != nil && compareVersions(r.Lower, r.Upper) == 0 {
+		return exact + r.Lower.String(), nil
+	}
+	var comparators []string
+	if r.Lower != nil {
+		op := ">"
+		if r.LowerInclusive {
+			op = ">="
+		}
+		comparators = append(comparators, op+r.Lower.String())
+	}
+	if r.Upper != nil {
+		op := "<"
+		if r.UpperInclusive {
+			op = "<="
+		}
+		comparators = append(comparators, op+r.Upper.String())
+	}
+	if len(comparators) == 0 {
+		return "*", nil
+	}
+	return strings.Join(comparators, separator), nil
+}
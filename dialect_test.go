@@ -0,0 +1,56 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestRange_StringDialect(t *testing.T) {
+	halfOpen := semver.Range{Lower: mustParse(t, "1.0.0"), LowerInclusive: true, Upper: mustParse(t, "2.0.0")}
+	exact := semver.Range{Lower: mustParse(t, "1.2.3"), LowerInclusive: true, Upper: mustParse(t, "1.2.3"), UpperInclusive: true}
+	epoch, err := semver.ParseVersionWithOptions("1:2.0.0", semver.ParseOptions{Epoch: true})
+	if err != nil {
+		t.Fatalf("Error parsing version: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		r           semver.Range
+		dialect     semver.Dialect
+		expected    string
+		expectedErr string
+	}{
+		{"npm", halfOpen, semver.DialectNPM, ">=1.0.0 <2.0.0", ""},
+		{"cargo", halfOpen, semver.DialectCargo, ">=1.0.0, <2.0.0", ""},
+		{"composer", halfOpen, semver.DialectComposer, ">=1.0.0 <2.0.0", ""},
+		{"npm exact", exact, semver.DialectNPM, "1.2.3", ""},
+		{"cargo exact", exact, semver.DialectCargo, "=1.2.3", ""},
+		{"inclusive upper", semver.Range{Lower: mustParse(t, "1.0.0"), Upper: mustParse(t, "1.2.0-rc.1"), UpperInclusive: true},
+			semver.DialectNPM, ">1.0.0 <=1.2.0-rc.1", ""},
+		{"unbounded", semver.Range{}, semver.DialectCargo, "*", ""},
+		{"empty", semver.Range{Lower: mustParse(t, "2.0.0"), Upper: mustParse(t, "1.0.0")}, semver.DialectNPM, "",
+			"range is empty"},
+		{"epoch", semver.Range{Lower: epoch, LowerInclusive: true}, semver.DialectComposer, "",
+			"version 1:2.0.0 has an epoch, which composer can't express"},
+		{"unknown dialect", halfOpen, 0, "", "unknown dialect 0"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := test.r.StringDialect(test.dialect)
+			if err != nil {
+				if err.Error() != test.expectedErr {
+					t.Errorf("Expected error %q, got %q", test.expectedErr, err)
+				}
+				return
+			}
+			if test.expectedErr != "" {
+				t.Fatalf("Expected error %q, got nil", test.expectedErr)
+			}
+			if result != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package semver
+
+import (
+	"fmt"
+)
+
+// changeLevel is the most significant part that differs between two versions.
+type changeLevel int
+
+const (
+	levelNone changeLevel = iota
+	levelBuild
+	levelPreRelease
+	levelPatch
+	levelMinor
+	levelMajor
+)
+
+func (l changeLevel) String() string {
+	switch l {
+	case levelBuild:
+		return "build"
+	case levelPreRelease:
+		return "pre-release"
+	case levelPatch:
+		return "patch"
+	case levelMinor:
+		return "minor"
+	case levelMajor:
+		return "major"
+	default:
+		return "no"
+	}
+}
+
+func changeLevelOf(a, b *Version) changeLevel {
+	switch {
+	case a.Epoch != b.Epoch, a.Major != b.Major:
+		return levelMajor
+	case a.Minor != b.Minor:
+		return levelMinor
+	case a.Patch != b.Patch:
+		return levelPatch
+	case a.PreRelease != b.PreRelease:
+		return levelPreRelease
+	case a.Build != b.Build:
+		return levelBuild
+	default:
+		return levelNone
+	}
+}
+
+// FormatDiff returns a human-readable summary of the change from version a to version b, e.g.
+//
+//	minor upgrade: 1.4.2-rc.1 → 1.6.0, leaves pre-release rc.1
+//	major downgrade: 2.0.0 → 1.9.0
+//	build change: 1.0.0+1 → 1.0.0+2
+//	no change: 1.0.0 → 1.0.0
+func FormatDiff(a, b *Version) string {
+	level := changeLevelOf(a, b)
+
+	var kind string
+	switch {
+	case level == levelNone || level == levelBuild:
+		kind = "change"
+	case compareVersions(a, b) < 0:
+		kind = "upgrade"
+	default:
+		kind = "downgrade"
+	}
+
+	summary := fmt.Sprintf("%s %s: %s → %s", level, kind, a, b)
+	switch {
+	case a.PreRelease != "" && b.PreRelease == "":
+		summary += ", leaves pre-release " + a.PreRelease
+	case a.PreRelease == "" && b.PreRelease != "":
+		summary += ", enters pre-release " + b.PreRelease
+	}
+	return summary
+}
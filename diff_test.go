@@ -0,0 +1,32 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestFormatDiff(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected string
+	}{
+		{"1.4.2", "1.6.0", "minor upgrade: 1.4.2 → 1.6.0"},
+		{"1.4.2-rc.1", "1.6.0", "minor upgrade: 1.4.2-rc.1 → 1.6.0, leaves pre-release rc.1"},
+		{"1.6.0", "2.0.0-beta.1", "major upgrade: 1.6.0 → 2.0.0-beta.1, enters pre-release beta.1"},
+		{"2.0.0", "1.9.0", "major downgrade: 2.0.0 → 1.9.0"},
+		{"1.0.0", "1.0.1", "patch upgrade: 1.0.0 → 1.0.1"},
+		{"1.0.0-rc.1", "1.0.0-rc.2", "pre-release upgrade: 1.0.0-rc.1 → 1.0.0-rc.2"},
+		{"1.0.0-rc.1", "1.0.0", "pre-release upgrade: 1.0.0-rc.1 → 1.0.0, leaves pre-release rc.1"},
+		{"1.0.0+1", "1.0.0+2", "build change: 1.0.0+1 → 1.0.0+2"},
+		{"1.0.0", "1.0.0", "no change: 1.0.0 → 1.0.0"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.a+" → "+test.b, func(t *testing.T) {
+			if result := semver.FormatDiff(mustParse(t, test.a), mustParse(t, test.b)); result != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, result)
+			}
+		})
+	}
+}
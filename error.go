@@ -2,13 +2,155 @@ package semver
 
 import (
 	"fmt"
+	"sync/atomic"
 )
 
 type ParseError struct {
 	Position int
 	Message  string
+	// Kind is a stable identifier of the error, Message is the English message for Kind and Args.
+	Kind ErrorKind
+	// Args are the parameters of the message, see the documentation of the error kinds.
+	Args []any
 }
 
 func (e *ParseError) Error() string {
+	if r := messageRenderer.Load(); r != nil && e.Kind != "" {
+		return (*r)(e)
+	}
 	return fmt.Sprintf("%s (at position %d)", e.Message, e.Position)
 }
+
+// ErrorKind is a stable identifier of a ParseError for rendering custom (e.g. translated) messages.
+type ErrorKind string
+
+// Error kinds of versions.
+const (
+	// KindUnexpectedEnd has no args.
+	KindUnexpectedEnd ErrorKind = "unexpected_end"
+	// KindMissingDot has no args.
+	KindMissingDot ErrorKind = "missing_dot"
+	// KindLeadingZero has no args.
+	KindLeadingZero ErrorKind = "leading_zero"
+	// KindExpectedDigit has the unexpected character (string) as arg.
+	KindExpectedDigit ErrorKind = "expected_digit"
+	// KindNumberOutOfRange has the number (string) as arg.
+	KindNumberOutOfRange ErrorKind = "number_out_of_range"
+	// KindExpectedIdentifier has the unexpected character (string) as arg.
+	KindExpectedIdentifier ErrorKind = "expected_identifier"
+	// KindTrailingCharacters has the remaining input (string) as arg.
+	KindTrailingCharacters ErrorKind = "trailing_characters"
+	// KindMissingEpochColon has no args.
+	KindMissingEpochColon ErrorKind = "missing_epoch_colon"
+)
+
+// Error kinds of patterns.
+const (
+	// KindTooManyComponents has no args.
+	KindTooManyComponents ErrorKind = "too_many_components"
+	// KindIncompletePattern has no args.
+	KindIncompletePattern ErrorKind = "incomplete_pattern"
+	// KindEmptyComponent has no args.
+	KindEmptyComponent ErrorKind = "empty_component"
+	// KindInvalidComponent has the component (string) and the reason (string) as args.
+	KindInvalidComponent ErrorKind = "invalid_component"
+	// KindInvalidPreReleasePattern has the pre-release (string) and the reason (string) as args.
+	KindInvalidPreReleasePattern ErrorKind = "invalid_prerelease_pattern"
+	// KindInvalidBuildPattern has the build (string) and the reason (string) as args.
+	KindInvalidBuildPattern ErrorKind = "invalid_build_pattern"
+)
+
+// Error kinds of expressions. Tokens are given as strings like `"minor"` or `end of input`.
+const (
+	// KindUnexpectedToken has the token (string) as arg.
+	KindUnexpectedToken ErrorKind = "unexpected_token"
+	// KindExpectedClosingParen has the unexpected token (string) as arg.
+	KindExpectedClosingParen ErrorKind = "expected_closing_paren"
+	// KindExpectedField has the unexpected token (string) as arg.
+	KindExpectedField ErrorKind = "expected_field"
+	// KindExpectedOperator has the unexpected token (string) as arg.
+	KindExpectedOperator ErrorKind = "expected_operator"
+	// KindUnsupportedOperator has the operator (string) and the field (string) as args.
+	KindUnsupportedOperator ErrorKind = "unsupported_operator"
+	// KindExpectedInteger has the unexpected token (string) as arg.
+	KindExpectedInteger ErrorKind = "expected_integer"
+	// KindInvalidInteger has the token (string) as arg.
+	KindInvalidInteger ErrorKind = "invalid_integer"
+	// KindExpectedString has the unexpected token (string) as arg.
+	KindExpectedString ErrorKind = "expected_string"
+	// KindInvalidVersion has the version (string) and the reason (string) as args.
+	KindInvalidVersion ErrorKind = "invalid_version"
+	// KindUnknownField has the field token (string) as arg.
+	KindUnknownField ErrorKind = "unknown_field"
+	// KindUnterminatedString has no args.
+	KindUnterminatedString ErrorKind = "unterminated_string"
+	// KindInvalidString has the string literal (string) as arg.
+	KindInvalidString ErrorKind = "invalid_string"
+	// KindUnexpectedCharacter has the character (rune) as arg.
+	KindUnexpectedCharacter ErrorKind = "unexpected_character"
+)
+
+// Error kinds of layouts.
+const (
+	// KindUnterminatedPlaceholder has no args.
+	KindUnterminatedPlaceholder ErrorKind = "unterminated_placeholder"
+	// KindInvalidPlaceholder has the placeholder (string) as arg.
+	KindInvalidPlaceholder ErrorKind = "invalid_placeholder"
+)
+
+var messageFormats = map[ErrorKind]string{
+	KindUnexpectedEnd:            "unexpected end of input",
+	KindMissingDot:               "missing dot separator",
+	KindLeadingZero:              "leading zero is not allowed",
+	KindExpectedDigit:            "expected positive digit, got %s",
+	KindNumberOutOfRange:         "number %s is out of range",
+	KindExpectedIdentifier:       "expected alphanumeric identifier, got %s",
+	KindTrailingCharacters:       "unexpected trailing characters: %q",
+	KindMissingEpochColon:        "missing colon after epoch",
+	KindTooManyComponents:        "too many components",
+	KindIncompletePattern:        "pattern must have three components or end with *",
+	KindEmptyComponent:           "empty component",
+	KindInvalidComponent:         "invalid component %q: %s",
+	KindInvalidPreReleasePattern: "invalid pre-release %q: %s",
+	KindInvalidBuildPattern:      "invalid build %q: %s",
+	KindUnexpectedToken:          "unexpected %s",
+	KindExpectedClosingParen:     `expected ")", got %s`,
+	KindExpectedField:            "expected field, got %s",
+	KindExpectedOperator:         "expected comparison operator, got %s",
+	KindUnsupportedOperator:      "operator %q is not supported for field %q",
+	KindExpectedInteger:          "expected integer, got %s",
+	KindInvalidInteger:           "invalid integer %s",
+	KindExpectedString:           "expected string, got %s",
+	KindInvalidVersion:           "invalid version %q: %s",
+	KindUnknownField:             "unknown field %s",
+	KindUnterminatedString:       "unterminated string",
+	KindInvalidString:            "invalid string %s",
+	KindUnexpectedCharacter:      "unexpected character %q",
+	KindUnterminatedPlaceholder:  "unterminated placeholder",
+	KindInvalidPlaceholder:       "invalid placeholder %q",
+}
+
+func newParseError(position int, kind ErrorKind, args ...any) *ParseError {
+	return &ParseError{
+		Position: position,
+		Message:  fmt.Sprintf(messageFormats[kind], args...),
+		Kind:     kind,
+		Args:     args,
+	}
+}
+
+// MessageRenderer renders the complete message of a ParseError, e.g. a translation based on its Kind and Args.
+type MessageRenderer func(e *ParseError) string
+
+var messageRenderer atomic.Pointer[MessageRenderer]
+
+// SetMessageRenderer sets the renderer used by ParseError.Error, nil restores the default English messages.
+// Parse functions may wrap a ParseError with English context (e.g. "invalid version core: "), use errors.As to get
+// the ParseError for a fully translated message.
+func SetMessageRenderer(r MessageRenderer) {
+	if r == nil {
+		messageRenderer.Store(nil)
+		return
+	}
+	messageRenderer.Store(&r)
+}
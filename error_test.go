@@ -0,0 +1,67 @@
+package semver_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestParseError_Kind(t *testing.T) {
+	tests := []struct {
+		version      string
+		expectedKind semver.ErrorKind
+		expectedArgs string
+	}{
+		{"1.00.0", semver.KindLeadingZero, "[]"},
+		{"1.0.", semver.KindUnexpectedEnd, "[]"},
+		{"1.0", semver.KindMissingDot, "[]"},
+		{"1.x.0", semver.KindExpectedDigit, "[x]"},
+		{"1.0.0-rc.1_2", semver.KindTrailingCharacters, "[_2]"},
+		{"99999999999999999999.0.0", semver.KindNumberOutOfRange, "[99999999999999999999]"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			_, err := semver.ParseVersion(test.version)
+
+			var parseErr *semver.ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Expected *ParseError, got %v", err)
+			}
+			if parseErr.Kind != test.expectedKind {
+				t.Errorf("Expected kind %q, got %q", test.expectedKind, parseErr.Kind)
+			}
+			if args := fmt.Sprint(parseErr.Args); args != test.expectedArgs {
+				t.Errorf("Expected args %s, got %s", test.expectedArgs, args)
+			}
+		})
+	}
+}
+
+func TestSetMessageRenderer(t *testing.T) {
+	messages := map[semver.ErrorKind]string{
+		semver.KindLeadingZero: "führende Null ist nicht erlaubt",
+	}
+	semver.SetMessageRenderer(func(e *semver.ParseError) string {
+		if message, ok := messages[e.Kind]; ok {
+			return fmt.Sprintf("%s (an Position %d)", message, e.Position)
+		}
+		return fmt.Sprintf("%s (an Position %d)", e.Message, e.Position)
+	})
+	defer semver.SetMessageRenderer(nil)
+
+	_, err := semver.ParseVersion("1.00.0")
+	expected := "invalid version core: minor: führende Null ist nicht erlaubt (an Position 2)"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error %q, got %v", expected, err)
+	}
+
+	semver.SetMessageRenderer(nil)
+	_, err = semver.ParseVersion("1.00.0")
+	expected = "invalid version core: minor: leading zero is not allowed (at position 2)"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error %q, got %v", expected, err)
+	}
+}
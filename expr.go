@@ -0,0 +1,483 @@
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/*
+
+Grammar for version expressions
+
+<expr>       ::= <and> | <and> "||" <expr>
+
+<and>        ::= <unary> | <unary> "&&" <and>
+
+<unary>      ::= "!" <unary>
+               | "(" <expr> ")"
+               | <comparison>
+
+<comparison> ::= <int field> <operator> <integer>
+               | <string field> ( "==" | "!=" | "contains" ) <string>
+               | "version" <operator> <string>
+
+<int field>    ::= "major" | "minor" | "patch"
+
+<string field> ::= "prerelease" | "build"
+
+<operator>   ::= "==" | "!=" | "<" | "<=" | ">" | ">="
+
+*/
+
+// Expr is a compiled predicate over a Version.
+//
+// An expression compares the integer fields major, minor and patch, the string fields prerelease and build and the
+// whole version (by precedence, given as a string literal that may have an epoch like "1:2.0.0") to literals.
+// Comparisons can be combined with &&, || and ! and grouped with parentheses:
+//
+//	major >= 2 && prerelease == "" && build contains "linux"
+//	version >= "1.4.0" && !(version == "1.5.2")
+type Expr struct {
+	source string
+	root   exprNode
+}
+
+// CompileExpr compiles an expression and returns an Expr or an error if the expression is invalid.
+func CompileExpr(expr string) (*Expr, error) {
+	p := &exprParser{input: expr}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokenEOF {
+		return nil, newParseError(p.tok.pos, KindUnexpectedToken, p.tok.String())
+	}
+
+	return &Expr{source: expr, root: root}, nil
+}
+
+// Match determines if the version satisfies the expression.
+func (e *Expr) Match(v *Version) bool {
+	return e.root.eval(v, nil)
+}
+
+// TraceFunc is called for each comparison evaluated by MatchTrace with the comparison (e.g. `major >= 2`) and its
+// outcome.
+type TraceFunc func(comparison string, matched bool)
+
+// MatchTrace determines if the version satisfies the expression like Match and reports each evaluated comparison to
+// trace, e.g. to debug why a version was rejected. Comparisons skipped by short-circuit evaluation are not reported.
+func (e *Expr) MatchTrace(v *Version, trace TraceFunc) bool {
+	return e.root.eval(v, trace)
+}
+
+// String returns the source of the expression.
+func (e *Expr) String() string {
+	return e.source
+}
+
+// Ranges returns the versions matched by the expression as a union of ranges sorted by their lower bound (see
+// MergeRanges), e.g. to translate it to SQL or to the events of an OSV advisory. It returns false if the expression
+// compares a field other than the version (except the constant comparisons `major >= 0` and `major < 0`), the versions
+// matched by such a comparison are not a union of ranges by precedence.
+func (e *Expr) Ranges() ([]Range, bool) {
+	return exprRanges(e.root)
+}
+
+// exprRanges returns the versions matched by the node as a union of ranges.
+func exprRanges(n exprNode) ([]Range, bool) {
+	switch n := n.(type) {
+	case andNode:
+		left, ok := exprRanges(n.left)
+		if !ok {
+			return nil, false
+		}
+		right, ok := exprRanges(n.right)
+		if !ok {
+			return nil, false
+		}
+		return intersectRanges(left, right), true
+	case orNode:
+		left, ok := exprRanges(n.left)
+		if !ok {
+			return nil, false
+		}
+		right, ok := exprRanges(n.right)
+		if !ok {
+			return nil, false
+		}
+		return MergeRanges(append(left, right...)), true
+	case notNode:
+		operand, ok := exprRanges(n.operand)
+		if !ok {
+			return nil, false
+		}
+		return complementRanges(operand), true
+	case versionComparison:
+		return comparisonRanges(n.op, n.value), true
+	case intComparison:
+		// With epochs the versions of a major version are no range, except for the constant comparisons of
+		// Range.String.
+		if n.field == "major" && n.value == 0 {
+			switch n.op {
+			case ">=":
+				return []Range{{}}, true
+			case "<":
+				return nil, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// comparisonRanges returns the versions that satisfy a comparison with the operator to v as a union of ranges.
+func comparisonRanges(op string, v *Version) []Range {
+	switch op {
+	case "==":
+		return []Range{{Lower: v, LowerInclusive: true, Upper: v, UpperInclusive: true}}
+	case "!=":
+		return []Range{{Upper: v}, {Lower: v}}
+	case "<", "<=":
+		return []Range{{Upper: v, UpperInclusive: op == "<="}}
+	default: // > and >=
+		return []Range{{Lower: v, LowerInclusive: op == ">="}}
+	}
+}
+
+type exprNode interface {
+	// eval evaluates the node for v and reports comparisons to trace if it is not nil.
+	eval(v *Version, trace TraceFunc) bool
+}
+
+type andNode struct{ left, right exprNode }
+
+func (n andNode) eval(v *Version, trace TraceFunc) bool {
+	return n.left.eval(v, trace) && n.right.eval(v, trace)
+}
+
+type orNode struct{ left, right exprNode }
+
+func (n orNode) eval(v *Version, trace TraceFunc) bool {
+	return n.left.eval(v, trace) || n.right.eval(v, trace)
+}
+
+type notNode struct{ operand exprNode }
+
+func (n notNode) eval(v *Version, trace TraceFunc) bool { return !n.operand.eval(v, trace) }
+
+// traced reports the result of a comparison to trace if it is not nil and returns the result.
+func traced(c fmt.Stringer, result bool, trace TraceFunc) bool {
+	if trace != nil {
+		trace(c.String(), result)
+	}
+	return result
+}
+
+type intComparison struct {
+	field string
+	op    string
+	value int
+}
+
+func (n intComparison) String() string {
+	return fmt.Sprintf("%s %s %d", n.field, n.op, n.value)
+}
+
+func (n intComparison) eval(v *Version, trace TraceFunc) bool {
+	var actual int
+	switch n.field {
+	case "major":
+		actual = v.Major
+	case "minor":
+		actual = v.Minor
+	case "patch":
+		actual = v.Patch
+	}
+	return traced(n, compareResultMatches(compareInts(actual, n.value), n.op), trace)
+}
+
+type stringComparison struct {
+	field string
+	op    string
+	value string
+}
+
+func (n stringComparison) String() string {
+	return fmt.Sprintf("%s %s %q", n.field, n.op, n.value)
+}
+
+func (n stringComparison) eval(v *Version, trace TraceFunc) bool {
+	actual := v.PreRelease
+	if n.field == "build" {
+		actual = v.Build
+	}
+	var result bool
+	switch n.op {
+	case "==":
+		result = actual == n.value
+	case "!=":
+		result = actual != n.value
+	default: // contains
+		result = strings.Contains(actual, n.value)
+	}
+	return traced(n, result, trace)
+}
+
+type versionComparison struct {
+	op    string
+	value *Version
+}
+
+func (n versionComparison) String() string {
+	return fmt.Sprintf("version %s %q", n.op, n.value)
+}
+
+func (n versionComparison) eval(v *Version, trace TraceFunc) bool {
+	return traced(n, compareResultMatches(compareVersions(v, n.value), n.op), trace)
+}
+
+// compareResultMatches determines if the result of a comparison (-1, 0 or 1) satisfies the operator.
+func compareResultMatches(result int, op string) bool {
+	switch op {
+	case "==":
+		return result == 0
+	case "!=":
+		return result != 0
+	case "<":
+		return result < 0
+	case "<=":
+		return result <= 0
+	case ">":
+		return result > 0
+	default: // >=
+		return result >= 0
+	}
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenInt
+	tokenString
+	tokenOperator
+)
+
+type token struct {
+	kind  tokenKind
+	pos   int
+	text  string
+	value string
+}
+
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return "end of input"
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+type exprParser struct {
+	input string
+	pos   int
+	tok   token
+}
+
+func (p *exprParser) parseOr() (exprNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.kind == tokenOperator && p.tok.text == "||" {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseAnd() (exprNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.kind == tokenOperator && p.tok.text == "&&" {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseUnary() (exprNode, error) {
+	if p.tok.kind == tokenOperator && p.tok.text == "!" {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{operand: operand}, nil
+	}
+
+	if p.tok.kind == tokenOperator && p.tok.text == "(" {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.tok.kind != tokenOperator || p.tok.text != ")" {
+			return nil, newParseError(p.tok.pos, KindExpectedClosingParen, p.tok.String())
+		}
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		return node, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *exprParser) parseComparison() (exprNode, error) {
+	if p.tok.kind != tokenIdent {
+		return nil, newParseError(p.tok.pos, KindExpectedField, p.tok.String())
+	}
+	field := p.tok
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	op := p.tok
+	if !isComparisonOperator(op) {
+		return nil, newParseError(op.pos, KindExpectedOperator, op.String())
+	}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	literal := p.tok
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	switch field.text {
+	case "major", "minor", "patch":
+		if op.text == "contains" {
+			return nil, newParseError(op.pos, KindUnsupportedOperator, op.text, field.text)
+		}
+		if literal.kind != tokenInt {
+			return nil, newParseError(literal.pos, KindExpectedInteger, literal.String())
+		}
+		n, err := strconv.Atoi(literal.text)
+		if err != nil {
+			return nil, newParseError(literal.pos, KindInvalidInteger, literal.String())
+		}
+		return intComparison{field: field.text, op: op.text, value: n}, nil
+	case "prerelease", "build":
+		if op.text != "==" && op.text != "!=" && op.text != "contains" {
+			return nil, newParseError(op.pos, KindUnsupportedOperator, op.text, field.text)
+		}
+		if literal.kind != tokenString {
+			return nil, newParseError(literal.pos, KindExpectedString, literal.String())
+		}
+		return stringComparison{field: field.text, op: op.text, value: literal.value}, nil
+	case "version":
+		if op.text == "contains" {
+			return nil, newParseError(op.pos, KindUnsupportedOperator, op.text, field.text)
+		}
+		if literal.kind != tokenString {
+			return nil, newParseError(literal.pos, KindExpectedString, literal.String())
+		}
+		v, err := ParseVersionWithOptions(literal.value, ParseOptions{Epoch: true})
+		if err != nil {
+			return nil, newParseError(literal.pos, KindInvalidVersion, literal.value, err.Error())
+		}
+		return versionComparison{op: op.text, value: v}, nil
+	default:
+		return nil, newParseError(field.pos, KindUnknownField, field.String())
+	}
+}
+
+func isComparisonOperator(t token) bool {
+	switch t.text {
+	case "==", "!=", "<", "<=", ">", ">=":
+		return t.kind == tokenOperator
+	case "contains":
+		return t.kind == tokenIdent
+	}
+	return false
+}
+
+// next scans the next token of the input into p.tok.
+func (p *exprParser) next() error {
+	for p.pos < len(p.input) && (p.input[p.pos] == ' ' || p.input[p.pos] == '\t' || p.input[p.pos] == '\n' || p.input[p.pos] == '\r') {
+		p.pos++
+	}
+
+	start := p.pos
+	if p.pos >= len(p.input) {
+		p.tok = token{kind: tokenEOF, pos: start}
+		return nil
+	}
+
+	ch := p.input[p.pos]
+	switch {
+	case ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z' || ch == '_':
+		for p.pos < len(p.input) && (p.input[p.pos] >= 'a' && p.input[p.pos] <= 'z' || p.input[p.pos] >= 'A' && p.input[p.pos] <= 'Z' || p.input[p.pos] == '_') {
+			p.pos++
+		}
+		p.tok = token{kind: tokenIdent, pos: start, text: p.input[start:p.pos]}
+	case ch >= '0' && ch <= '9':
+		for p.pos < len(p.input) && p.input[p.pos] >= '0' && p.input[p.pos] <= '9' {
+			p.pos++
+		}
+		p.tok = token{kind: tokenInt, pos: start, text: p.input[start:p.pos]}
+	case ch == '"':
+		p.pos++
+		for p.pos < len(p.input) && p.input[p.pos] != '"' {
+			if p.input[p.pos] == '\\' {
+				p.pos++
+			}
+			p.pos++
+		}
+		if p.pos >= len(p.input) {
+			return newParseError(start, KindUnterminatedString)
+		}
+		p.pos++
+		text := p.input[start:p.pos]
+		value, err := strconv.Unquote(text)
+		if err != nil {
+			return newParseError(start, KindInvalidString, text)
+		}
+		p.tok = token{kind: tokenString, pos: start, text: text, value: value}
+	default:
+		for _, op := range []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "!", "(", ")"} {
+			if strings.HasPrefix(p.input[p.pos:], op) {
+				p.pos += len(op)
+				p.tok = token{kind: tokenOperator, pos: start, text: op}
+				return nil
+			}
+		}
+		return newParseError(start, KindUnexpectedCharacter, rune(ch))
+	}
+	return nil
+}
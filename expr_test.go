@@ -0,0 +1,143 @@
+package semver_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestExpr(t *testing.T) {
+	tests := []struct {
+		expr     string
+		version  string
+		expected bool
+	}{
+		{`major >= 2`, "2.0.0", true},
+		{`major >= 2`, "1.9.9", false},
+		{`major == 1 && minor < 5`, "1.4.9", true},
+		{`major == 1 && minor < 5`, "1.5.0", false},
+		{`patch != 0 || prerelease != ""`, "1.0.0-rc.1", true},
+		{`major >= 2 && prerelease == "" && build contains "linux"`, "2.1.0+linux.amd64", true},
+		{`major >= 2 && prerelease == "" && build contains "linux"`, "2.1.0-rc.1+linux.amd64", false},
+		{`prerelease contains "rc"`, "1.0.0-rc.1", true},
+		{`version >= "1.4.0" && !(version == "1.5.2")`, "1.5.2", false},
+		{`version >= "1.4.0" && !(version == "1.5.2")`, "1.5.3", true},
+		{`version < "1.0.0"`, "1.0.0-alpha", true},
+		{`version == "1.0.0"`, "1.0.0+build", true},
+		{`!major == 1 || minor > 0 && patch > 0`, "1.0.1", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expr+" with "+test.version, func(t *testing.T) {
+			e, err := semver.CompileExpr(test.expr)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			v := mustParse(t, test.version)
+
+			if result := e.Match(v); result != test.expected {
+				t.Errorf("Expected %q to match %q to be %v, got %v", test.expr, test.version, test.expected, result)
+			}
+		})
+	}
+}
+
+func TestCompileExpr_Errors(t *testing.T) {
+	tests := []struct {
+		expr        string
+		expectedErr string
+	}{
+		{``, `expected field, got end of input (at position 0)`},
+		{`major`, `expected comparison operator, got end of input (at position 5)`},
+		{`major >= "2"`, `expected integer, got "\"2\"" (at position 9)`},
+		{`major contains 2`, `operator "contains" is not supported for field "major" (at position 6)`},
+		{`prerelease < "rc"`, `operator "<" is not supported for field "prerelease" (at position 11)`},
+		{`flavor == "x"`, `unknown field "flavor" (at position 0)`},
+		{`(major == 1`, `expected ")", got end of input (at position 11)`},
+		{`major == 1 minor == 2`, `unexpected "minor" (at position 11)`},
+		{`build == "linux`, `unterminated string (at position 9)`},
+		{`major = 1`, `unexpected character '=' (at position 6)`},
+		{`version > "1.0"`, `invalid version "1.0": invalid version core: missing dot separator (at position 3) (at position 10)`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			_, err := semver.CompileExpr(test.expr)
+			if err == nil {
+				t.Fatalf("Expected error %q, got nil", test.expectedErr)
+			}
+			if err.Error() != test.expectedErr {
+				t.Errorf("Expected error %q, got %q", test.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestExpr_MatchTrace(t *testing.T) {
+	e, err := semver.CompileExpr(`(major >= 2 || build contains "linux") && !(version == "2.1.0")`)
+	if err != nil {
+		t.Fatalf("Error compiling expression: %v", err)
+	}
+
+	var trace []string
+	matched := e.MatchTrace(mustParse(t, "1.4.0+linux"), func(comparison string, matched bool) {
+		trace = append(trace, fmt.Sprintf("%s: %v", comparison, matched))
+	})
+
+	if !matched {
+		t.Errorf("Expected version to match")
+	}
+	expected := `[major >= 2: false build contains "linux": true version == "2.1.0": false]`
+	if fmt.Sprint(trace) != expected {
+		t.Errorf("Expected trace %s, got %v", expected, trace)
+	}
+}
+
+func TestExpr_Ranges(t *testing.T) {
+	tests := []struct {
+		expr     string
+		expected []string
+	}{
+		{`version >= "1.0.0" && version < "1.5.0" || version >= "1.4.0" && version < "2.0.0"`,
+			[]string{`version >= "1.0.0" && version < "2.0.0"`}},
+		{`version >= "1.0.0" && !(version == "1.2.0")`,
+			[]string{`version >= "1.0.0" && version < "1.2.0"`, `version > "1.2.0"`}},
+		{`version != "1.0.0" && version <= "2.0.0"`,
+			[]string{`version < "1.0.0"`, `version > "1.0.0" && version <= "2.0.0"`}},
+		{`!(version < "1.0.0" || version >= "2.0.0")`,
+			[]string{`version >= "1.0.0" && version < "2.0.0"`}},
+		{`version > "2.0.0" && version < "1.0.0"`, nil},
+		{`major >= 0`, []string{`major >= 0`}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			e, err := semver.CompileExpr(test.expr)
+			if err != nil {
+				t.Fatalf("Error compiling expression: %v", err)
+			}
+			ranges, ok := e.Ranges()
+			if !ok {
+				t.Fatalf("Expected ranges")
+			}
+			var result []string
+			for _, r := range ranges {
+				result = append(result, r.String())
+			}
+			if fmt.Sprintf("%q", result) != fmt.Sprintf("%q", test.expected) {
+				t.Errorf("Expected %q, got %q", test.expected, result)
+			}
+		})
+	}
+
+	for _, expr := range []string{`major >= 2`, `version >= "1.0.0" && prerelease == ""`} {
+		e, err := semver.CompileExpr(expr)
+		if err != nil {
+			t.Fatalf("Error compiling expression: %v", err)
+		}
+		if _, ok := e.Ranges(); ok {
+			t.Errorf("Expected no ranges for %q", expr)
+		}
+	}
+}
@@ -0,0 +1,14 @@
+package semver
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// Fingerprint returns the hex-encoded SHA-256 hash of the precedence-relevant form of the version (the version
+// without build metadata). Versions with equal precedence have the same fingerprint, so it can be used to
+// deduplicate versions across data sources.
+func (v *Version) Fingerprint() string {
+	sum := sha256.Sum256([]byte(v.precedenceString()))
+	return hex.EncodeToString(sum[:])
+}
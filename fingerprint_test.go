@@ -0,0 +1,43 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestFingerprint(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected string
+	}{
+		{"1.2.3", "c47f5b18b8a430e698b9fe15e51f6119984e78334bcf3f45e210d30c37ef2f9e"},
+		{"1.2.3+build.42", "c47f5b18b8a430e698b9fe15e51f6119984e78334bcf3f45e210d30c37ef2f9e"},
+		{"1.2.3-rc.1", "41528b4acecd96e4423575cce6a690b0e30638e03c4741f535bed974457bbaca"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			if fingerprint := mustParse(t, test.version).Fingerprint(); fingerprint != test.expected {
+				t.Errorf("Expected fingerprint %s, got %s", test.expected, fingerprint)
+			}
+		})
+	}
+}
+
+func TestFingerprint_Epoch(t *testing.T) {
+	parse := func(s string) *semver.Version {
+		v, err := semver.ParseVersionWithOptions(s, semver.ParseOptions{Epoch: true})
+		if err != nil {
+			t.Fatalf("Error parsing version %q: %v", s, err)
+		}
+		return v
+	}
+
+	if parse("1:1.2.3+a").Fingerprint() != parse("1:1.2.3+b").Fingerprint() {
+		t.Errorf("Expected versions of equal precedence to have the same fingerprint")
+	}
+	if parse("1:1.2.3").Fingerprint() == parse("1.2.3").Fingerprint() {
+		t.Errorf("Expected versions with different epochs to have different fingerprints")
+	}
+}
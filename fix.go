@@ -0,0 +1,15 @@
+package semver
+
+// FirstFixAtOrAbove returns the fixed version with the lowest precedence at or above current. The lowest fix stays on
+// the release line of current if possible, since fixes on the same major and minor version precede those of later
+// lines: for a 1.4.x user, 1.4.9 is chosen over 2.0.1 and 1.5.0 over 2.0.0.
+// It returns nil if no fixed version is at or above current.
+func FirstFixAtOrAbove(current *Version, fixed []*Version) *Version {
+	var first *Version
+	for _, v := range fixed {
+		if compareVersions(v, current) >= 0 && (first == nil || v.Before(first)) {
+			first = v
+		}
+	}
+	return first
+}
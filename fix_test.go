@@ -0,0 +1,38 @@
+package semver_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestFirstFixAtOrAbove(t *testing.T) {
+	var fixed []*semver.Version
+	for _, s := range []string{"2.0.1", "1.4.9", "1.3.7", "1.5.0", "3.0.0"} {
+		fixed = append(fixed, mustParse(t, s))
+	}
+
+	tests := []struct {
+		current  string
+		expected string
+	}{
+		{"1.4.2", "1.4.9"},
+		{"1.4.9", "1.4.9"},
+		{"1.4.10", "1.5.0"},
+		{"1.3.0", "1.3.7"},
+		{"1.0.0", "1.3.7"},
+		{"2.0.0", "2.0.1"},
+		{"2.1.0", "3.0.0"},
+		{"3.0.1", "<nil>"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.current, func(t *testing.T) {
+			got := semver.FirstFixAtOrAbove(mustParse(t, test.current), fixed)
+			if fmt.Sprint(got) != test.expected {
+				t.Errorf("Expected %s, got %v", test.expected, got)
+			}
+		})
+	}
+}
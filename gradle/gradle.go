@@ -0,0 +1,147 @@
+// Package gradle evaluates Gradle (and Ivy) dynamic versions against candidate versions.
+//
+// Supported selectors are prefixes ("1.+", "1.2.+", "+"), status selectors ("latest.release",
+// "latest.milestone", "latest.integration") and ranges in Maven/Ivy notation ("[1.0,2.0)", "[1.0,)", "]1.0,2.0[").
+// Like Gradle, a selector resolves to the matching candidate with the highest precedence.
+package gradle
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/networkteam/semver"
+)
+
+// Statuses of versions for status selectors, from the least to the most mature.
+const (
+	StatusIntegration = "integration"
+	StatusMilestone   = "milestone"
+	StatusRelease     = "release"
+)
+
+var statusRanks = map[string]int{StatusIntegration: 1, StatusMilestone: 2, StatusRelease: 3}
+
+// DefaultStatus is the status of versions without status information: releases have the status release and
+// pre-releases the status integration.
+func DefaultStatus(v *semver.Version) string {
+	if v.PreRelease == "" {
+		return StatusRelease
+	}
+	return StatusIntegration
+}
+
+// Selector is a parsed dynamic version. It implements semver.Matcher.
+type Selector struct {
+	// Status returns the status of a version for status selectors, DefaultStatus is used if it is nil.
+	Status func(v *semver.Version) string
+
+	source string
+	// prefix are the fixed components of a prefix selector, nil matches any version.
+	prefix []int
+	// status is the minimum status of a status selector.
+	status string
+	rng    *semver.Range
+}
+
+// Parse parses a dynamic version.
+func Parse(s string) (*Selector, error) {
+	sel := &Selector{source: s}
+	switch {
+	case s == "+":
+		return sel, nil
+	case strings.HasSuffix(s, ".+"):
+		parts := strings.Split(strings.TrimSuffix(s, ".+"), ".")
+		if len(parts) > 2 {
+			return nil, fmt.Errorf("invalid dynamic version %q: too many components", s)
+		}
+		for _, part := range parts {
+			n, err := strconv.Atoi(part)
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("invalid dynamic version %q: invalid component %q", s, part)
+			}
+			sel.prefix = append(sel.prefix, n)
+		}
+		return sel, nil
+	case strings.HasPrefix(s, "latest."):
+		sel.status = strings.TrimPrefix(s, "latest.")
+		if _, ok := statusRanks[sel.status]; !ok {
+			return nil, fmt.Errorf("invalid dynamic version %q: unknown status %q", s, sel.status)
+		}
+		return sel, nil
+	case strings.ContainsAny(s[:min(len(s), 1)], "[]("):
+		r, err := parseRange(s)
+		if err != nil {
+			return nil, err
+		}
+		sel.rng = &r
+		return sel, nil
+	}
+	return nil, fmt.Errorf("invalid dynamic version %q", s)
+}
+
+// parseRange parses a range like "[1.0,2.0)". Ivy writes exclusive bounds with outward brackets like "]1.0,2.0[".
+func parseRange(s string) (semver.Range, error) {
+	if len(s) < 3 {
+		return semver.Range{}, fmt.Errorf("invalid version range %q", s)
+	}
+	opening, closing := s[0], s[len(s)-1]
+	lower, upper, found := strings.Cut(s[1:len(s)-1], ",")
+	if !found || !strings.ContainsRune("[](", rune(opening)) || !strings.ContainsRune("[])", rune(closing)) {
+		return semver.Range{}, fmt.Errorf("invalid version range %q", s)
+	}
+
+	r := semver.Range{LowerInclusive: opening == '[', UpperInclusive: closing == ']'}
+	for _, bound := range []struct {
+		value string
+		v     **semver.Version
+	}{{lower, &r.Lower}, {upper, &r.Upper}} {
+		value := strings.TrimSpace(bound.value)
+		if value == "" {
+			continue
+		}
+		pv, err := semver.ParsePartial(value)
+		if err != nil {
+			return semver.Range{}, fmt.Errorf("invalid version range %q: %w", s, err)
+		}
+		*bound.v = pv.Version()
+	}
+	return r, nil
+}
+
+// Match determines if the version is matched by the selector.
+func (s *Selector) Match(v *semver.Version) bool {
+	switch {
+	case s.rng != nil:
+		return s.rng.Match(v)
+	case s.status != "":
+		status := s.Status
+		if status == nil {
+			status = DefaultStatus
+		}
+		return statusRanks[status(v)] >= statusRanks[s.status]
+	}
+	components := []int{v.Major, v.Minor}
+	for i, n := range s.prefix {
+		if components[i] != n {
+			return false
+		}
+	}
+	return true
+}
+
+// Select returns the matching candidate with the highest precedence, it returns nil if no candidate matches.
+func (s *Selector) Select(candidates []*semver.Version) *semver.Version {
+	var selected *semver.Version
+	for _, v := range candidates {
+		if s.Match(v) && (selected == nil || selected.Before(v)) {
+			selected = v
+		}
+	}
+	return selected
+}
+
+// String returns the source of the selector.
+func (s *Selector) String() string {
+	return s.source
+}
@@ -0,0 +1,89 @@
+package gradle_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/gradle"
+)
+
+func TestSelector_Select(t *testing.T) {
+	var candidates []*semver.Version
+	for _, s := range []string{"1.0.0", "1.2.0", "1.2.5", "1.10.0", "2.0.0-rc.1", "2.0.0", "2.1.0-beta.1", "3.0.0-M1"} {
+		v, err := semver.ParseVersion(s)
+		if err != nil {
+			t.Fatalf("Error parsing version %q: %v", s, err)
+		}
+		candidates = append(candidates, v)
+	}
+
+	tests := []struct {
+		selector    string
+		expected    string
+		expectedErr string
+	}{
+		{"+", "3.0.0-M1", ""},
+		{"1.+", "1.10.0", ""},
+		{"1.2.+", "1.2.5", ""},
+		{"4.+", "<nil>", ""},
+		{"latest.release", "2.0.0", ""},
+		{"latest.integration", "3.0.0-M1", ""},
+		{"[1.0,2.0)", "2.0.0-rc.1", ""},
+		{"[1.0,1.2]", "1.2.0", ""},
+		{"]1.0,1.2.5[", "1.2.0", ""},
+		{"(,1.2.5)", "1.2.0", ""},
+		{"[2.0,)", "3.0.0-M1", ""},
+		{"1.2.3.+", "", `invalid dynamic version "1.2.3.+": too many components`},
+		{"latest.snapshot", "", `invalid dynamic version "latest.snapshot": unknown status "snapshot"`},
+		{"[1.0;2.0]", "", `invalid version range "[1.0;2.0]"`},
+		{"1.2", "", `invalid dynamic version "1.2"`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.selector, func(t *testing.T) {
+			sel, err := gradle.Parse(test.selector)
+			if err != nil {
+				if err.Error() != test.expectedErr {
+					t.Errorf("Expected error %q, got %q", test.expectedErr, err)
+				}
+				return
+			}
+			if test.expectedErr != "" {
+				t.Fatalf("Expected error %q, got nil", test.expectedErr)
+			}
+			if got := fmt.Sprint(sel.Select(candidates)); got != test.expected {
+				t.Errorf("Expected %s, got %s", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestSelector_Status(t *testing.T) {
+	sel, err := gradle.Parse("latest.milestone")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	sel.Status = func(v *semver.Version) string {
+		switch {
+		case v.PreRelease == "":
+			return gradle.StatusRelease
+		case v.PreReleaseAt(0) == "rc":
+			return gradle.StatusMilestone
+		default:
+			return gradle.StatusIntegration
+		}
+	}
+
+	var candidates []*semver.Version
+	for _, s := range []string{"1.0.0", "1.1.0-rc.1", "1.1.0-alpha.1"} {
+		v, err := semver.ParseVersion(s)
+		if err != nil {
+			t.Fatalf("Error parsing version %q: %v", s, err)
+		}
+		candidates = append(candidates, v)
+	}
+	if got := sel.Select(candidates); got.String() != "1.1.0-rc.1" {
+		t.Errorf("Expected %q, got %q", "1.1.0-rc.1", got)
+	}
+}
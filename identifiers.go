@@ -0,0 +1,51 @@
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PreReleaseLen returns the number of pre-release identifiers.
+func (v *Version) PreReleaseLen() int {
+	return identifierCount(v.PreRelease)
+}
+
+// PreReleaseAt returns the pre-release identifier at index i, it panics if i is out of range.
+func (v *Version) PreReleaseAt(i int) string {
+	return identifierAt(v.PreRelease, i, "pre-release")
+}
+
+// BuildLen returns the number of build identifiers.
+func (v *Version) BuildLen() int {
+	return identifierCount(v.Build)
+}
+
+// BuildAt returns the build identifier at index i, it panics if i is out of range.
+func (v *Version) BuildAt(i int) string {
+	return identifierAt(v.Build, i, "build")
+}
+
+func identifierCount(s string) int {
+	if s == "" {
+		return 0
+	}
+	return strings.Count(s, ".") + 1
+}
+
+func identifierAt(s string, i int, part string) string {
+	if i >= 0 && s != "" {
+		rest := s
+		for ; i > 0; i-- {
+			_, after, found := strings.Cut(rest, ".")
+			if !found {
+				break
+			}
+			rest = after
+		}
+		if i == 0 {
+			identifier, _, _ := strings.Cut(rest, ".")
+			return identifier
+		}
+	}
+	panic(fmt.Sprintf("semver: %s identifier index %d out of range", part, i))
+}
@@ -0,0 +1,51 @@
+package semver_test
+
+import (
+	"testing"
+)
+
+func TestIdentifierAccessors(t *testing.T) {
+	v := mustParse(t, "1.0.0-alpha.1.x-y+build.2024")
+
+	if n := v.PreReleaseLen(); n != 3 {
+		t.Errorf("Expected 3 pre-release identifiers, got %d", n)
+	}
+	for i, expected := range []string{"alpha", "1", "x-y"} {
+		if identifier := v.PreReleaseAt(i); identifier != expected {
+			t.Errorf("Expected pre-release identifier %d to be %q, got %q", i, expected, identifier)
+		}
+	}
+	if n := v.BuildLen(); n != 2 {
+		t.Errorf("Expected 2 build identifiers, got %d", n)
+	}
+	if identifier := v.BuildAt(1); identifier != "2024" {
+		t.Errorf("Expected build identifier 1 to be %q, got %q", "2024", identifier)
+	}
+
+	stable := mustParse(t, "1.0.0")
+	if stable.PreReleaseLen() != 0 || stable.BuildLen() != 0 {
+		t.Errorf("Expected no identifiers for %s", stable)
+	}
+}
+
+func TestIdentifierAccessors_OutOfRange(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"pre-release index too high", func() { mustParse(t, "1.0.0-rc.1").PreReleaseAt(2) }},
+		{"negative index", func() { mustParse(t, "1.0.0-rc.1").PreReleaseAt(-1) }},
+		{"no build", func() { mustParse(t, "1.0.0").BuildAt(0) }},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Expected panic")
+				}
+			}()
+			test.fn()
+		})
+	}
+}
@@ -0,0 +1,306 @@
+package semver
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// indexDegree is the minimum number of children of an inner node of the B-tree of an Index (except for the root).
+const indexDegree = 32
+
+// Index is a sorted set of versions backed by a B-tree, for registries tracking many versions per package.
+//
+// Insertions and deletions take logarithmic time, unlike a sorted slice. Versions are ordered by precedence and
+// then by build metadata, two versions are considered the same element if they are equal and have the same build
+// metadata (like in VersionList).
+// An Index is not safe for concurrent use. The zero value is an empty index ready to use.
+type Index struct {
+	root   *indexNode
+	length int
+}
+
+type indexNode struct {
+	items    []*Version
+	children []*indexNode
+}
+
+// NewIndex returns an index containing the given versions.
+func NewIndex(versions ...*Version) *Index {
+	idx, _ := NewIndexContext(context.Background(), versions...)
+	return idx
+}
+
+// NewIndexContext returns an index containing the given versions like NewIndex, but returns the error of the context
+// if it is done before all versions were inserted.
+func NewIndexContext(ctx context.Context, versions ...*Version) (*Index, error) {
+	idx := &Index{}
+	for i, v := range versions {
+		if err := checkContext(ctx, i); err != nil {
+			return nil, err
+		}
+		idx.Insert(v)
+	}
+	return idx, nil
+}
+
+// Len returns the number of versions in the index.
+func (idx *Index) Len() int {
+	return idx.length
+}
+
+// Insert adds a version to the index and reports whether it was not already present.
+// The version must not be modified after it was added.
+func (idx *Index) Insert(v *Version) bool {
+	if idx.root == nil {
+		idx.root = &indexNode{items: []*Version{v}}
+		idx.length = 1
+		return true
+	}
+	if len(idx.root.items) >= 2*indexDegree-1 {
+		item, second := idx.root.split(indexDegree - 1)
+		idx.root = &indexNode{items: []*Version{item}, children: []*indexNode{idx.root, second}}
+	}
+	if !idx.root.insert(v) {
+		return false
+	}
+	idx.length++
+	return true
+}
+
+// Delete removes a version from the index and reports whether it was present.
+func (idx *Index) Delete(v *Version) bool {
+	if idx.root == nil {
+		return false
+	}
+	removed := idx.root.remove(v)
+	if len(idx.root.items) == 0 {
+		if len(idx.root.children) > 0 {
+			idx.root = idx.root.children[0]
+		} else {
+			idx.root = nil
+		}
+	}
+	if removed {
+		idx.length--
+	}
+	return removed
+}
+
+// Contains determines if the index contains the given version (including the build metadata).
+func (idx *Index) Contains(v *Version) bool {
+	for n := idx.root; n != nil; {
+		i, found := findIndexItem(n.items, v)
+		if found {
+			return true
+		}
+		if len(n.children) == 0 {
+			return false
+		}
+		n = n.children[i]
+	}
+	return false
+}
+
+// Floor returns the version with the highest precedence that is lower than or equal to v.
+func (idx *Index) Floor(v *Version) (*Version, bool) {
+	var result *Version
+	for n := idx.root; n != nil; {
+		i := sort.Search(len(n.items), func(i int) bool { return compareVersions(n.items[i], v) > 0 })
+		if i > 0 {
+			result = n.items[i-1]
+		}
+		if len(n.children) == 0 {
+			break
+		}
+		n = n.children[i]
+	}
+	return result, result != nil
+}
+
+// Ceiling returns the version with the lowest precedence that is higher than or equal to v.
+func (idx *Index) Ceiling(v *Version) (*Version, bool) {
+	var result *Version
+	for n := idx.root; n != nil; {
+		i := sort.Search(len(n.items), func(i int) bool { return compareVersions(n.items[i], v) >= 0 })
+		if i < len(n.items) {
+			result = n.items[i]
+		}
+		if len(n.children) == 0 {
+			break
+		}
+		n = n.children[i]
+	}
+	return result, result != nil
+}
+
+// Ascend calls fn for each version of the index in ascending order until fn returns false.
+func (idx *Index) Ascend(fn func(v *Version) bool) {
+	idx.AscendRange(nil, nil, fn)
+}
+
+// AscendRange calls fn in ascending order for each version with a precedence higher than or equal to from and
+// lower than to until fn returns false. A nil bound is unbounded.
+func (idx *Index) AscendRange(from, to *Version, fn func(v *Version) bool) {
+	if idx.root != nil {
+		idx.root.ascend(from, to, fn)
+	}
+}
+
+// AscendMatching calls fn in ascending order for each version matching m until fn returns false.
+func (idx *Index) AscendMatching(m Matcher, fn func(v *Version) bool) {
+	idx.Ascend(func(v *Version) bool {
+		if !m.Match(v) {
+			return true
+		}
+		return fn(v)
+	})
+}
+
+// compareIndexItems orders versions by precedence and then by build metadata.
+func compareIndexItems(a, b *Version) int {
+	if result := compareVersions(a, b); result != 0 {
+		return result
+	}
+	return strings.Compare(a.Build, b.Build)
+}
+
+// findIndexItem returns the position of v in the items or the position of the child that would contain it.
+func findIndexItem(items []*Version, v *Version) (int, bool) {
+	i := sort.Search(len(items), func(i int) bool { return compareIndexItems(v, items[i]) < 0 })
+	if i > 0 && compareIndexItems(items[i-1], v) == 0 {
+		return i - 1, true
+	}
+	return i, false
+}
+
+// split splits the node at item i and returns the item and a new node with the items and children after it.
+func (n *indexNode) split(i int) (*Version, *indexNode) {
+	item := n.items[i]
+	next := &indexNode{items: slices.Clone(n.items[i+1:])}
+	n.items = slices.Delete(n.items, i, len(n.items))
+	if len(n.children) > 0 {
+		next.children = slices.Clone(n.children[i+1:])
+		n.children = slices.Delete(n.children, i+1, len(n.children))
+	}
+	return item, next
+}
+
+func (n *indexNode) insert(v *Version) bool {
+	i, found := findIndexItem(n.items, v)
+	if found {
+		return false
+	}
+	if len(n.children) == 0 {
+		n.items = slices.Insert(n.items, i, v)
+		return true
+	}
+
+	// Split a full child before descending, so it can take the new item.
+	if len(n.children[i].items) >= 2*indexDegree-1 {
+		item, second := n.children[i].split(indexDegree - 1)
+		n.items = slices.Insert(n.items, i, item)
+		n.children = slices.Insert(n.children, i+1, second)
+		switch result := compareIndexItems(v, item); {
+		case result == 0:
+			return false
+		case result > 0:
+			i++
+		}
+	}
+	return n.children[i].insert(v)
+}
+
+// remove removes v from the subtree, every node it descends into has more than the minimum number of items.
+func (n *indexNode) remove(v *Version) bool {
+	i, found := findIndexItem(n.items, v)
+	if len(n.children) == 0 {
+		if found {
+			n.items = slices.Delete(n.items, i, i+1)
+		}
+		return found
+	}
+
+	if len(n.children[i].items) <= indexDegree-1 {
+		n.growChild(i)
+		return n.remove(v)
+	}
+	if found {
+		n.items[i] = n.children[i].removeMax()
+		return true
+	}
+	return n.children[i].remove(v)
+}
+
+// removeMax removes and returns the version with the highest precedence of the subtree.
+func (n *indexNode) removeMax() *Version {
+	if len(n.children) == 0 {
+		last := n.items[len(n.items)-1]
+		n.items = slices.Delete(n.items, len(n.items)-1, len(n.items))
+		return last
+	}
+	i := len(n.items)
+	if len(n.children[i].items) <= indexDegree-1 {
+		n.growChild(i)
+		return n.removeMax()
+	}
+	return n.children[i].removeMax()
+}
+
+// growChild adds an item to child i by taking one from a sibling or by merging it with a sibling.
+func (n *indexNode) growChild(i int) {
+	switch {
+	case i > 0 && len(n.children[i-1].items) > indexDegree-1:
+		child, left := n.children[i], n.children[i-1]
+		child.items = slices.Insert(child.items, 0, n.items[i-1])
+		n.items[i-1] = left.items[len(left.items)-1]
+		left.items = slices.Delete(left.items, len(left.items)-1, len(left.items))
+		if len(left.children) > 0 {
+			child.children = slices.Insert(child.children, 0, left.children[len(left.children)-1])
+			left.children = slices.Delete(left.children, len(left.children)-1, len(left.children))
+		}
+	case i < len(n.items) && len(n.children[i+1].items) > indexDegree-1:
+		child, right := n.children[i], n.children[i+1]
+		child.items = append(child.items, n.items[i])
+		n.items[i] = right.items[0]
+		right.items = slices.Delete(right.items, 0, 1)
+		if len(right.children) > 0 {
+			child.children = append(child.children, right.children[0])
+			right.children = slices.Delete(right.children, 0, 1)
+		}
+	default:
+		if i >= len(n.items) {
+			i--
+		}
+		child, right := n.children[i], n.children[i+1]
+		child.items = append(child.items, n.items[i])
+		child.items = append(child.items, right.items...)
+		child.children = append(child.children, right.children...)
+		n.items = slices.Delete(n.items, i, i+1)
+		n.children = slices.Delete(n.children, i+1, i+2)
+	}
+}
+
+// ascend visits the versions of the subtree in the range and returns false if the iteration was stopped.
+func (n *indexNode) ascend(from, to *Version, fn func(v *Version) bool) bool {
+	i := 0
+	if from != nil {
+		i = sort.Search(len(n.items), func(i int) bool { return compareVersions(n.items[i], from) >= 0 })
+	}
+	for ; i < len(n.items); i++ {
+		if len(n.children) > 0 && !n.children[i].ascend(from, to, fn) {
+			return false
+		}
+		if to != nil && compareVersions(n.items[i], to) >= 0 {
+			return false
+		}
+		if !fn(n.items[i]) {
+			return false
+		}
+	}
+	if len(n.children) > 0 {
+		return n.children[len(n.items)].ascend(from, to, fn)
+	}
+	return true
+}
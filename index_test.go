@@ -0,0 +1,122 @@
+package semver_test
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestIndex_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	idx := semver.NewIndex()
+	reference := make(map[string]*semver.Version)
+
+	for i := 0; i < 20000; i++ {
+		v := &semver.Version{Major: r.Intn(20), Minor: r.Intn(20), Patch: r.Intn(20)}
+		if r.Intn(4) == 0 {
+			v.Build = fmt.Sprintf("b%d", r.Intn(3))
+		}
+		key := v.String()
+		if r.Intn(3) == 0 {
+			_, present := reference[key]
+			if removed := idx.Delete(v); removed != present {
+				t.Fatalf("Expected Delete(%s) to return %v", key, present)
+			}
+			delete(reference, key)
+		} else {
+			_, present := reference[key]
+			if added := idx.Insert(v); added == present {
+				t.Fatalf("Expected Insert(%s) to return %v", key, !present)
+			}
+			reference[key] = v
+		}
+	}
+
+	var expected []*semver.Version
+	for _, v := range reference {
+		expected = append(expected, v)
+	}
+	sort.Slice(expected, func(i, j int) bool {
+		if expected[i].Equals(expected[j]) {
+			return expected[i].Build < expected[j].Build
+		}
+		return expected[i].Before(expected[j])
+	})
+
+	var got []*semver.Version
+	idx.Ascend(func(v *semver.Version) bool {
+		got = append(got, v)
+		return true
+	})
+	if idx.Len() != len(expected) {
+		t.Errorf("Expected length %d, got %d", len(expected), idx.Len())
+	}
+	if fmt.Sprint(versionStrings(got)) != fmt.Sprint(versionStrings(expected)) {
+		t.Errorf("Expected versions in ascending order")
+	}
+	for _, v := range expected {
+		if !idx.Contains(v) {
+			t.Errorf("Expected index to contain %s", v)
+		}
+	}
+}
+
+func TestIndex_FloorCeiling(t *testing.T) {
+	idx := semver.NewIndex(mustParse(t, "1.0.0"), mustParse(t, "1.2.0"), mustParse(t, "2.0.0-rc.1"), mustParse(t, "2.0.0"))
+
+	tests := []struct {
+		version string
+		floor   string
+		ceiling string
+	}{
+		{"0.9.0", "", "1.0.0"},
+		{"1.0.0", "1.0.0", "1.0.0"},
+		{"1.1.0", "1.0.0", "1.2.0"},
+		{"2.0.0-beta", "1.2.0", "2.0.0-rc.1"},
+		{"3.0.0", "2.0.0", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			v := mustParse(t, test.version)
+			if floor, ok := idx.Floor(v); ok != (test.floor != "") || ok && floor.String() != test.floor {
+				t.Errorf("Expected floor %q, got %v", test.floor, floor)
+			}
+			if ceiling, ok := idx.Ceiling(v); ok != (test.ceiling != "") || ok && ceiling.String() != test.ceiling {
+				t.Errorf("Expected ceiling %q, got %v", test.ceiling, ceiling)
+			}
+		})
+	}
+}
+
+func TestIndex_AscendRange(t *testing.T) {
+	idx := semver.NewIndex()
+	for i := 0; i < 1000; i++ {
+		idx.Insert(&semver.Version{Major: i / 100, Minor: i % 100})
+	}
+
+	var got []*semver.Version
+	idx.AscendRange(mustParse(t, "3.98.0"), mustParse(t, "4.2.0"), func(v *semver.Version) bool {
+		got = append(got, v)
+		return true
+	})
+	if fmt.Sprint(versionStrings(got)) != "[3.98.0 3.99.0 4.0.0 4.1.0]" {
+		t.Errorf("Unexpected versions %v", versionStrings(got))
+	}
+
+	expr, err := semver.CompileExpr(`minor == 42`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	got = nil
+	idx.AscendMatching(expr, func(v *semver.Version) bool {
+		got = append(got, v)
+		return len(got) < 3
+	})
+	if fmt.Sprint(versionStrings(got)) != "[0.42.0 1.42.0 2.42.0]" {
+		t.Errorf("Unexpected versions %v", versionStrings(got))
+	}
+}
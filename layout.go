@@ -0,0 +1,226 @@
+package semver
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Layout is a compiled template for names derived from a version, e.g. of artifacts or images:
+//
+//	myapp_{major}.{minor}.{patch}{-prerelease}_linux_amd64
+//
+// A placeholder in braces names a field and can have a literal prefix and suffix of non-letter characters, e.g.
+// {-prerelease}. The whole placeholder is omitted if the value of the field is empty, so {-prerelease} renders
+// "-rc.1" for a pre-release and nothing for a release. The version fields are version, major, minor, patch,
+// prerelease and build, other names are custom fields given to Format and returned by Parse.
+type Layout struct {
+	source   string
+	segments []layoutSegment
+	// re matches names rendered by the layout, with a group for each placeholder.
+	re *regexp.Regexp
+}
+
+// layoutSegment is a literal (if field is empty) or a placeholder.
+type layoutSegment struct {
+	literal string
+	prefix  string
+	field   string
+	suffix  string
+}
+
+// CompileLayout compiles a layout and returns a Layout or an error if the layout is invalid.
+func CompileLayout(layout string) (*Layout, error) {
+	l := &Layout{source: layout}
+	rest := layout
+	pos := 0
+	for rest != "" {
+		open := strings.IndexByte(rest, '{')
+		if open < 0 {
+			l.segments = append(l.segments, layoutSegment{literal: rest})
+			break
+		}
+		if open > 0 {
+			l.segments = append(l.segments, layoutSegment{literal: rest[:open]})
+		}
+		end := strings.IndexByte(rest[open:], '}')
+		if end < 0 {
+			return nil, newParseError(pos+open, KindUnterminatedPlaceholder)
+		}
+		placeholder := rest[open+1 : open+end]
+
+		start := strings.IndexFunc(placeholder, isLayoutLetter)
+		stop := strings.LastIndexFunc(placeholder, isLayoutLetter)
+		if start < 0 || strings.ContainsFunc(placeholder[start:stop+1], func(r rune) bool { return !isLayoutLetter(r) }) ||
+			strings.ContainsAny(placeholder, "{") {
+			return nil, newParseError(pos+open, KindInvalidPlaceholder, placeholder)
+		}
+		l.segments = append(l.segments, layoutSegment{
+			prefix: placeholder[:start],
+			field:  placeholder[start : stop+1],
+			suffix: placeholder[stop+1:],
+		})
+
+		pos += open + end + 1
+		rest = rest[open+end+1:]
+	}
+
+	var expr strings.Builder
+	expr.WriteString("^")
+	for _, s := range l.segments {
+		if s.field == "" {
+			expr.WriteString(regexp.QuoteMeta(s.literal))
+			continue
+		}
+		group := "(" + fieldPattern(s.field) + ")"
+		group = regexp.QuoteMeta(s.prefix) + group + regexp.QuoteMeta(s.suffix)
+		if s.field != "major" && s.field != "minor" && s.field != "patch" && s.field != "version" {
+			group = "(?:" + group + ")?"
+		}
+		expr.WriteString(group)
+	}
+	expr.WriteString("$")
+	l.re = regexp.MustCompile(expr.String())
+
+	return l, nil
+}
+
+// fieldPattern returns the regular expression matching the value of a field.
+func fieldPattern(field string) string {
+	const identifiers = `[0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*`
+	switch field {
+	case "version":
+		return `(?:0|[1-9][0-9]*)\.(?:0|[1-9][0-9]*)\.(?:0|[1-9][0-9]*)(?:-` + identifiers + `)?(?:\+` + identifiers + `)?`
+	case "major", "minor", "patch":
+		return `0|[1-9][0-9]*`
+	case "prerelease", "build":
+		return identifiers
+	}
+	return `.+?`
+}
+
+func isLayoutLetter(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z'
+}
+
+// Format renders the layout for the version with the custom fields.
+// It returns an error if the layout has a custom field that is not given.
+func (l *Layout) Format(v *Version, fields map[string]string) (string, error) {
+	var sb strings.Builder
+	for _, s := range l.segments {
+		if s.field == "" {
+			sb.WriteString(s.literal)
+			continue
+		}
+		value, ok := versionField(v, s.field)
+		if !ok {
+			value, ok = fields[s.field]
+			if !ok {
+				return "", fmt.Errorf("layout %q: missing field %q", l.source, s.field)
+			}
+		}
+		if value == "" {
+			continue
+		}
+		sb.WriteString(s.prefix)
+		sb.WriteString(value)
+		sb.WriteString(s.suffix)
+	}
+	return sb.String(), nil
+}
+
+// String returns the source of the layout.
+func (l *Layout) String() string {
+	return l.source
+}
+
+// Parse extracts the version and the custom fields from a name rendered by the layout, e.g. to index the
+// artifacts of a store. The layout must have the version field or the major, minor and patch fields.
+func (l *Layout) Parse(name string) (*Version, map[string]string, error) {
+	match := l.re.FindStringSubmatch(name)
+	if match == nil {
+		return nil, nil, fmt.Errorf("%q does not match layout %q", name, l.source)
+	}
+
+	values := make(map[string]string)
+	group := 1
+	for _, s := range l.segments {
+		if s.field == "" {
+			continue
+		}
+		value := match[group]
+		group++
+		if previous, ok := values[s.field]; ok && previous != value {
+			return nil, nil, fmt.Errorf("%q has different values for field %q", name, s.field)
+		}
+		values[s.field] = value
+	}
+
+	version, ok := values["version"]
+	if !ok {
+		major, hasMajor := values["major"]
+		minor, hasMinor := values["minor"]
+		patch, hasPatch := values["patch"]
+		if !hasMajor || !hasMinor || !hasPatch {
+			return nil, nil, fmt.Errorf("layout %q has no version or major, minor and patch fields", l.source)
+		}
+		version = major + "." + minor + "." + patch
+		if values["prerelease"] != "" {
+			version += "-" + values["prerelease"]
+		}
+		if values["build"] != "" {
+			version += "+" + values["build"]
+		}
+	}
+	v, err := ParseVersionWithOptions(version, ParseOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("%q has an invalid version: %w", name, err)
+	}
+
+	fields := make(map[string]string)
+	for field, value := range values {
+		if _, isVersionField := versionField(v, field); !isVersionField {
+			fields[field] = value
+		}
+	}
+	return v, fields, nil
+}
+
+// ParseLayout compiles the layout and parses the name with it, see Layout.Parse.
+func ParseLayout(layout, name string) (*Version, map[string]string, error) {
+	l, err := CompileLayout(layout)
+	if err != nil {
+		return nil, nil, err
+	}
+	return l.Parse(name)
+}
+
+// versionField returns the value of a version field of a layout.
+func versionField(v *Version, field string) (string, bool) {
+	switch field {
+	case "version":
+		return v.String(), true
+	case "major":
+		return strconv.Itoa(v.Major), true
+	case "minor":
+		return strconv.Itoa(v.Minor), true
+	case "patch":
+		return strconv.Itoa(v.Patch), true
+	case "prerelease":
+		return v.PreRelease, true
+	case "build":
+		return v.Build, true
+	}
+	return "", false
+}
+
+// FormatLayout compiles the layout and renders it for the version, see Layout.
+// It returns an error if the layout is invalid or has custom fields.
+func (v *Version) FormatLayout(layout string) (string, error) {
+	l, err := CompileLayout(layout)
+	if err != nil {
+		return "", err
+	}
+	return l.Format(v, nil)
+}
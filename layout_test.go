@@ -0,0 +1,98 @@
+package semver_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestVersion_FormatLayout(t *testing.T) {
+	tests := []struct {
+		version     string
+		layout      string
+		expected    string
+		expectedErr string
+	}{
+		{"1.2.3", "myapp_{major}.{minor}.{patch}{-prerelease}_linux_amd64", "myapp_1.2.3_linux_amd64", ""},
+		{"1.2.3-rc.1", "myapp_{major}.{minor}.{patch}{-prerelease}_linux_amd64", "myapp_1.2.3-rc.1_linux_amd64", ""},
+		{"1.2.3-rc.1+sha.5114f85", "myapp-{version}.tar.gz", "myapp-1.2.3-rc.1+sha.5114f85.tar.gz", ""},
+		{"1.2.3+sha.5114f85", "image:{major}.{minor}{_build_}latest", "image:1.2_sha.5114f85_latest", ""},
+		{"1.2.3", "myapp_{version", "", "unterminated placeholder (at position 6)"},
+		{"1.2.3", "myapp_{-}", "", `invalid placeholder "-" (at position 6)`},
+		{"1.2.3", "myapp_{pre release}", "", `invalid placeholder "pre release" (at position 6)`},
+		{"1.2.3", "myapp_{version}_{os}", "", `layout "myapp_{version}_{os}": missing field "os"`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.layout, func(t *testing.T) {
+			got, err := mustParse(t, test.version).FormatLayout(test.layout)
+			if err != nil {
+				if err.Error() != test.expectedErr {
+					t.Errorf("Expected error %q, got %q", test.expectedErr, err)
+				}
+				return
+			}
+			if test.expectedErr != "" {
+				t.Fatalf("Expected error %q, got nil", test.expectedErr)
+			}
+			if got != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestLayout_Format(t *testing.T) {
+	l, err := semver.CompileLayout("myapp_{version}_{os}_{arch}")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	got, err := l.Format(mustParse(t, "2.0.0"), map[string]string{"os": "darwin", "arch": "arm64"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got != "myapp_2.0.0_darwin_arm64" {
+		t.Errorf("Expected %q, got %q", "myapp_2.0.0_darwin_arm64", got)
+	}
+}
+
+func TestParseLayout(t *testing.T) {
+	tests := []struct {
+		layout      string
+		name        string
+		expected    string
+		fields      string
+		expectedErr string
+	}{
+		{"myapp_{major}.{minor}.{patch}{-prerelease}_{os}_{arch}.tar.gz", "myapp_1.2.3-rc.1_linux_amd64.tar.gz", "1.2.3-rc.1", "map[arch:amd64 os:linux]", ""},
+		{"myapp_{major}.{minor}.{patch}{-prerelease}_{os}_{arch}.tar.gz", "myapp_1.2.3_linux_amd64.tar.gz", "1.2.3", "map[arch:amd64 os:linux]", ""},
+		{"myapp-{version}-{os}.zip", "myapp-1.2.3-beta.2+sha.5114f85-windows.zip", "1.2.3-beta.2+sha.5114f85", "map[os:windows]", ""},
+		{"myapp-{version}-{os}.zip", "myapp-1.2.3-darwin.zip", "1.2.3", "map[os:darwin]", ""},
+		{"myapp_{version}.tar.gz", "otherapp_1.2.3.tar.gz", "", "", `"otherapp_1.2.3.tar.gz" does not match layout "myapp_{version}.tar.gz"`},
+		{"myapp_{version}.tar.gz", "myapp_1.02.3.tar.gz", "", "", `"myapp_1.02.3.tar.gz" does not match layout "myapp_{version}.tar.gz"`},
+		{"myapp_{major}.{minor}.tar.gz", "myapp_1.2.tar.gz", "", "", `layout "myapp_{major}.{minor}.tar.gz" has no version or major, minor and patch fields`},
+		{"{os}/myapp_{version}_{os}", "linux/myapp_1.0.0_darwin", "", "", `"linux/myapp_1.0.0_darwin" has different values for field "os"`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v, fields, err := semver.ParseLayout(test.layout, test.name)
+			if err != nil {
+				if err.Error() != test.expectedErr {
+					t.Errorf("Expected error %q, got %q", test.expectedErr, err)
+				}
+				return
+			}
+			if test.expectedErr != "" {
+				t.Fatalf("Expected error %q, got nil", test.expectedErr)
+			}
+			if v.String() != test.expected {
+				t.Errorf("Expected version %q, got %q", test.expected, v)
+			}
+			if fmt.Sprint(fields) != test.fields {
+				t.Errorf("Expected fields %s, got %v", test.fields, fields)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package semver
+
+import "sort"
+
+// Lineage is the progression of pre-releases toward a release, e.g. 1.5.0-alpha.1, 1.5.0-beta.2 and 1.5.0-rc.1
+// toward 1.5.0.
+type Lineage struct {
+	// Target is the release without pre-release and build metadata the pre-releases lead to.
+	Target *Version
+	// PreReleases are the pre-releases of the target in ascending order of precedence.
+	PreReleases []*Version
+	// Release is the given version of the target, it is nil if the target has not been released yet.
+	Release *Version
+}
+
+// Lineages groups the versions by their target release and returns the lineages in ascending order of the targets.
+// Releases without pre-releases get a lineage without pre-releases.
+func Lineages(versions []*Version) []Lineage {
+	byTarget := make(map[Version]*Lineage)
+	for _, v := range versions {
+		key := Version{Epoch: v.Epoch, Major: v.Major, Minor: v.Minor, Patch: v.Patch}
+		l, ok := byTarget[key]
+		if !ok {
+			target := key
+			l = &Lineage{Target: &target}
+			byTarget[key] = l
+		}
+		if v.PreRelease == "" {
+			if l.Release == nil {
+				l.Release = v
+			}
+			continue
+		}
+		l.PreReleases = append(l.PreReleases, v)
+	}
+
+	lineages := make([]Lineage, 0, len(byTarget))
+	for _, l := range byTarget {
+		sort.SliceStable(l.PreReleases, func(i, j int) bool { return l.PreReleases[i].Before(l.PreReleases[j]) })
+		lineages = append(lineages, *l)
+	}
+	sort.Slice(lineages, func(i, j int) bool { return lineages[i].Target.Before(lineages[j].Target) })
+	return lineages
+}
@@ -0,0 +1,24 @@
+package semver_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestLineages(t *testing.T) {
+	var versions []*semver.Version
+	for _, s := range []string{"1.5.0-rc.1", "1.4.0", "1.5.0-alpha.1", "2.0.0-beta.1", "1.5.0", "1.5.0-beta.2", "2.0.0-alpha.3+build"} {
+		versions = append(versions, mustParse(t, s))
+	}
+
+	var got []string
+	for _, l := range semver.Lineages(versions) {
+		got = append(got, fmt.Sprintf("%s%v:%v", l.Target, versionStrings(l.PreReleases), l.Release))
+	}
+	expected := "[1.4.0[]:1.4.0 1.5.0[1.5.0-alpha.1 1.5.0-beta.2 1.5.0-rc.1]:1.5.0 2.0.0[2.0.0-alpha.3+build 2.0.0-beta.1]:<nil>]"
+	if fmt.Sprint(got) != expected {
+		t.Errorf("Expected %s, got %v", expected, got)
+	}
+}
@@ -0,0 +1,26 @@
+package semver
+
+import (
+	"log/slog"
+)
+
+// LogValue implements slog.LogValuer and logs the version as a group of its fields.
+// Epoch, pre-release and build metadata are omitted if empty. Log v.String() instead to get the canonical string.
+func (v *Version) LogValue() slog.Value {
+	var attrs []slog.Attr
+	if v.Epoch != 0 {
+		attrs = append(attrs, slog.Int("epoch", v.Epoch))
+	}
+	attrs = append(attrs,
+		slog.Int("major", v.Major),
+		slog.Int("minor", v.Minor),
+		slog.Int("patch", v.Patch),
+	)
+	if v.PreRelease != "" {
+		attrs = append(attrs, slog.String("prerelease", v.PreRelease))
+	}
+	if v.Build != "" {
+		attrs = append(attrs, slog.String("build", v.Build))
+	}
+	return slog.GroupValue(attrs...)
+}
@@ -0,0 +1,37 @@
+package semver_test
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+)
+
+func TestLogValue(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected string
+	}{
+		{"1.2.3", "msg=test version.major=1 version.minor=2 version.patch=3\n"},
+		{"1.2.3-rc.1+linux", "msg=test version.major=1 version.minor=2 version.patch=3 version.prerelease=rc.1 version.build=linux\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+				ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+					if a.Key == slog.TimeKey || a.Key == slog.LevelKey {
+						return slog.Attr{}
+					}
+					return a
+				},
+			}))
+
+			logger.Info("test", "version", mustParse(t, test.version))
+
+			if buf.String() != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, buf.String())
+			}
+		})
+	}
+}
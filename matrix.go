@@ -0,0 +1,37 @@
+package semver
+
+import (
+	"sort"
+)
+
+// CompareMatrix returns the pairwise comparison of the versions by precedence: the value at [i][j] is -1, 0 or 1 if
+// vs[i] has a lower, equal or higher precedence than vs[j].
+func CompareMatrix(vs []*Version) [][]int {
+	matrix := make([][]int, len(vs))
+	for i, a := range vs {
+		matrix[i] = make([]int, len(vs))
+		for j, b := range vs {
+			matrix[i][j] = compareVersions(a, b)
+		}
+	}
+	return matrix
+}
+
+// Tiers returns the versions in ascending order of precedence, grouped into tiers of versions with equal precedence
+// (e.g. only differing in build metadata). Versions of a tier keep their order of vs.
+func Tiers(vs []*Version) [][]*Version {
+	sorted := append([]*Version(nil), vs...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Before(sorted[j])
+	})
+
+	var tiers [][]*Version
+	for i, v := range sorted {
+		if i > 0 && v.Equals(sorted[i-1]) {
+			tiers[len(tiers)-1] = append(tiers[len(tiers)-1], v)
+			continue
+		}
+		tiers = append(tiers, []*Version{v})
+	}
+	return tiers
+}
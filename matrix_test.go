@@ -0,0 +1,41 @@
+package semver_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestCompareMatrix(t *testing.T) {
+	vs := []*semver.Version{
+		mustParse(t, "1.0.0"),
+		mustParse(t, "1.0.0-rc.1"),
+		mustParse(t, "1.0.0+build.1"),
+	}
+
+	expected := "[[0 1 0] [-1 0 -1] [0 1 0]]"
+	if matrix := semver.CompareMatrix(vs); fmt.Sprint(matrix) != expected {
+		t.Errorf("Expected matrix %s, got %v", expected, matrix)
+	}
+}
+
+func TestTiers(t *testing.T) {
+	vs := []*semver.Version{
+		mustParse(t, "1.0.0+b"),
+		mustParse(t, "1.0.0-beta.11"),
+		mustParse(t, "1.0.0-beta.2"),
+		mustParse(t, "1.0.0+a"),
+		mustParse(t, "1.0.0-alpha"),
+	}
+
+	var tiers []string
+	for _, tier := range semver.Tiers(vs) {
+		tiers = append(tiers, fmt.Sprint(versionStrings(tier)))
+	}
+
+	expected := "[[1.0.0-alpha] [1.0.0-beta.2] [1.0.0-beta.11] [1.0.0+b 1.0.0+a]]"
+	if fmt.Sprint(tiers) != expected {
+		t.Errorf("Expected tiers %s, got %v", expected, tiers)
+	}
+}
@@ -0,0 +1,51 @@
+//go:build go1.23
+
+package semver
+
+import "iter"
+
+// MergeSorted merges streams of versions in ascending order (see Index for the order) into one ascending stream
+// without buffering them. Duplicates (equal versions with the same build metadata) are yielded once, e.g. when
+// combining the version feeds of multiple mirrors.
+//
+// The result is only ordered if every stream is ordered.
+func MergeSorted(streams ...iter.Seq[*Version]) iter.Seq[*Version] {
+	return func(yield func(*Version) bool) {
+		nexts := make([]func() (*Version, bool), 0, len(streams))
+		heads := make([]*Version, 0, len(streams))
+		for _, stream := range streams {
+			next, stop := iter.Pull(stream)
+			defer stop()
+			if v, ok := next(); ok {
+				nexts = append(nexts, next)
+				heads = append(heads, v)
+			}
+		}
+
+		var last *Version
+		for len(heads) > 0 {
+			// The number of streams is usually small, so a linear scan beats a heap.
+			lowest := 0
+			for i := 1; i < len(heads); i++ {
+				if compareIndexItems(heads[i], heads[lowest]) < 0 {
+					lowest = i
+				}
+			}
+
+			v := heads[lowest]
+			if last == nil || compareIndexItems(last, v) != 0 {
+				if !yield(v) {
+					return
+				}
+				last = v
+			}
+
+			if next, ok := nexts[lowest](); ok {
+				heads[lowest] = next
+			} else {
+				nexts = append(nexts[:lowest], nexts[lowest+1:]...)
+				heads = append(heads[:lowest], heads[lowest+1:]...)
+			}
+		}
+	}
+}
@@ -0,0 +1,44 @@
+//go:build go1.23
+
+package semver_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestMergeSorted(t *testing.T) {
+	parse := func(versions ...string) []*semver.Version {
+		var result []*semver.Version
+		for _, s := range versions {
+			result = append(result, mustParse(t, s))
+		}
+		return result
+	}
+
+	merged := semver.MergeSorted(
+		slices.Values(parse("1.0.0", "1.2.0", "2.0.0")),
+		slices.Values(parse("1.0.0-rc.1", "1.2.0", "1.2.0+linux")),
+		slices.Values(parse()),
+		slices.Values(parse("1.1.0", "3.0.0")),
+	)
+
+	got := slices.Collect(merged)
+	if fmt.Sprint(versionStrings(got)) != "[1.0.0-rc.1 1.0.0 1.1.0 1.2.0 1.2.0+linux 2.0.0 3.0.0]" {
+		t.Errorf("Unexpected versions %v", versionStrings(got))
+	}
+
+	var first []*semver.Version
+	for v := range merged {
+		first = append(first, v)
+		if len(first) == 2 {
+			break
+		}
+	}
+	if fmt.Sprint(versionStrings(first)) != "[1.0.0-rc.1 1.0.0]" {
+		t.Errorf("Unexpected versions %v", versionStrings(first))
+	}
+}
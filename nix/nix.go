@@ -0,0 +1,96 @@
+// Package nix compares versions like Nix's builtins.compareVersions, e.g. to reconcile versions of nixpkgs (or Guix)
+// with semantic versions.
+//
+// Versions are split into components at "." and "-" and between digits and other characters, so "2.3a" has the
+// components "2", "3" and "a". Components are compared pairwise: numbers numerically, "pre" before anything else,
+// strings before numbers and strings lexically. A missing component sorts before a number.
+package nix
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Compare compares two versions like builtins.compareVersions and returns -1, 0 or 1.
+func Compare(a, b string) int {
+	for a != "" || b != "" {
+		var ca, cb string
+		ca, a = nextComponent(a)
+		cb, b = nextComponent(b)
+		if componentLess(ca, cb) {
+			return -1
+		}
+		if componentLess(cb, ca) {
+			return 1
+		}
+	}
+	return 0
+}
+
+// Components returns the components of a version, e.g. ["1", "2", "pre", "3"] for "1.2pre3".
+func Components(version string) []string {
+	var components []string
+	for version != "" {
+		var c string
+		c, version = nextComponent(version)
+		if c != "" {
+			components = append(components, c)
+		}
+	}
+	return components
+}
+
+func nextComponent(s string) (component, rest string) {
+	s = strings.TrimLeft(s, ".-")
+	if s == "" {
+		return "", ""
+	}
+	digits := isDigit(s[0])
+	i := 1
+	for i < len(s) && s[i] != '.' && s[i] != '-' && isDigit(s[i]) == digits {
+		i++
+	}
+	return s[:i], s[i:]
+}
+
+func componentLess(a, b string) bool {
+	na, errA := strconv.Atoi(a)
+	nb, errB := strconv.Atoi(b)
+	numericA, numericB := errA == nil && isDigit(a[0]), errB == nil && isDigit(b[0])
+	switch {
+	case numericA && numericB:
+		return na < nb
+	case a == "" && numericB:
+		return true
+	case a == "pre" && b != "pre":
+		return true
+	case b == "pre":
+		return false
+	case numericB:
+		// Like Nix, assume that "2.3a" < "2.3.1".
+		return true
+	case numericA:
+		return false
+	}
+	return a < b
+}
+
+// ParseDrvName splits a derivation or store name into the package name and the version like builtins.parseDrvName.
+// The version starts after the first dash that is followed by a character other than a letter, e.g.
+// "nix-0.12pre12876" is split into "nix" and "0.12pre12876". The version is empty if there is no such dash.
+func ParseDrvName(name string) (pname, version string) {
+	for i := 0; i+1 < len(name); i++ {
+		if name[i] == '-' && !isLetter(name[i+1]) {
+			return name[:i], name[i+1:]
+		}
+	}
+	return name, ""
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
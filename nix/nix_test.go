@@ -0,0 +1,71 @@
+package nix_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/networkteam/semver/nix"
+)
+
+func TestCompare(t *testing.T) {
+	// Cases from the Nix test suite of builtins.compareVersions.
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"1.0", "2.3", -1},
+		{"2.1", "2.3", -1},
+		{"2.3", "2.3", 0},
+		{"2.5", "2.3", 1},
+		{"3.1", "2.3", 1},
+		{"2.3.1", "2.3", 1},
+		{"2.3.1", "2.3a", 1},
+		{"2.3pre1", "2.3", -1},
+		{"2.3pre3", "2.3pre12", -1},
+		{"2.3a", "2.3c", -1},
+		{"2.3pre1", "2.3c", -1},
+		{"2.3pre1", "2.3q", -1},
+		{"2.3", "2.3-1", -1},
+		{"1.2.3-rc.1", "1.2.3-rc.2", -1},
+		{"2023-01-02", "2023-01-10", -1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.a+" <=> "+test.b, func(t *testing.T) {
+			if result := nix.Compare(test.a, test.b); result != test.expected {
+				t.Errorf("Expected %d, got %d", test.expected, result)
+			}
+			if result := nix.Compare(test.b, test.a); result != -test.expected {
+				t.Errorf("Expected %d for reversed arguments, got %d", -test.expected, result)
+			}
+		})
+	}
+}
+
+func TestComponents(t *testing.T) {
+	if got := fmt.Sprint(nix.Components("1.2pre3-rc.1")); got != "[1 2 pre 3 rc 1]" {
+		t.Errorf("Expected %s, got %s", "[1 2 pre 3 rc 1]", got)
+	}
+}
+
+func TestParseDrvName(t *testing.T) {
+	tests := []struct {
+		name    string
+		pname   string
+		version string
+	}{
+		{"nix-0.12pre12876", "nix", "0.12pre12876"},
+		{"a-b-c-1234-bla", "a-b-c", "1234-bla"},
+		{"hello", "hello", ""},
+		{"python3.11-requests-2.31.0", "python3.11-requests", "2.31.0"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pname, version := nix.ParseDrvName(test.name)
+			if pname != test.pname || version != test.version {
+				t.Errorf("Expected %q and %q, got %q and %q", test.pname, test.version, pname, version)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package semver
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+)
+
+// NonASCIIError is returned when parsing an input with a non-ASCII character, which is never valid in a version.
+// These usually come from copy-pasted documentation with typographic dashes or look-alike digits.
+type NonASCIIError struct {
+	// Position is the byte offset of the character in the input.
+	Position int
+	// Char is the character, utf8.RuneError for invalid UTF-8.
+	Char rune
+	// Suggestion is the ASCII character the character looks like or an empty string if there is none.
+	Suggestion string
+}
+
+func (e *NonASCIIError) Error() string {
+	if e.Suggestion != "" {
+		return fmt.Sprintf("non-ASCII character %q (U+%04X), did you mean %q? (at position %d)", e.Char, e.Char, e.Suggestion, e.Position)
+	}
+	return fmt.Sprintf("non-ASCII character %q (U+%04X) (at position %d)", e.Char, e.Char, e.Position)
+}
+
+// checkASCII returns a *NonASCIIError for the first non-ASCII character of the input.
+func checkASCII(input string) error {
+	for i := 0; i < len(input); i++ {
+		if input[i] < utf8.RuneSelf {
+			continue
+		}
+		r, _ := utf8.DecodeRuneInString(input[i:])
+		return &NonASCIIError{Position: i, Char: r, Suggestion: asciiLookAlike(r)}
+	}
+	return nil
+}
+
+// asciiLookAlike returns the ASCII character a Unicode character is commonly mistaken for.
+func asciiLookAlike(r rune) string {
+	switch r {
+	case '‐', '‑', '‒', '–', '—', '−', '﹣', '－':
+		return "-"
+	case '․', '。', '﹒', '．':
+		return "."
+	case '﹢', '＋':
+		return "+"
+	}
+
+	switch {
+	case unicode.IsDigit(r):
+		// Decimal digits are encoded in consecutive runs starting with zero
+		start := r
+		for unicode.IsDigit(start - 1) {
+			start--
+		}
+		return string(rune('0' + (r-start)%10))
+	case r >= 'Ａ' && r <= 'Ｚ':
+		return string(rune('A' + r - 'Ａ'))
+	case r >= 'ａ' && r <= 'ｚ':
+		return string(rune('a' + r - 'ａ'))
+	}
+	return ""
+}
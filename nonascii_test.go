@@ -0,0 +1,37 @@
+package semver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestParseVersion_NonASCII(t *testing.T) {
+	tests := []struct {
+		version     string
+		expectedErr string
+	}{
+		{"1.2.3‐1", `non-ASCII character '‐' (U+2010), did you mean "-"? (at position 5)`},
+		{"1.2.3–rc.1", `non-ASCII character '–' (U+2013), did you mean "-"? (at position 5)`},
+		{"１.0.0", `non-ASCII character '１' (U+FF11), did you mean "1"? (at position 0)`},
+		{"1.٢.0", `non-ASCII character '٢' (U+0662), did you mean "2"? (at position 2)`},
+		{"1.0.0-ｒｃ", `non-ASCII character 'ｒ' (U+FF52), did you mean "r"? (at position 6)`},
+		{"1.0.0+café", `non-ASCII character 'é' (U+00E9) (at position 9)`},
+		{"1.0.0\xff", `non-ASCII character '�' (U+FFFD) (at position 5)`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			_, err := semver.ParseVersion(test.version)
+
+			var nonASCIIErr *semver.NonASCIIError
+			if !errors.As(err, &nonASCIIErr) {
+				t.Fatalf("Expected *NonASCIIError, got %v", err)
+			}
+			if err.Error() != test.expectedErr {
+				t.Errorf("Expected error %q, got %q", test.expectedErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package semver
+
+// Normalize parses a version and returns its canonical string.
+//
+// Normalize is idempotent: for every s that is accepted, Normalize(Normalize(s)) returns the same string, and
+// ParseVersion of the result returns a version with the same fields. The canonical string can therefore be used as
+// an identity key (including the build metadata, use Fingerprint to ignore it).
+func Normalize(s string) (string, error) {
+	v, err := ParseVersionWithOptions(s, ParseOptions{})
+	if err != nil {
+		return "", err
+	}
+	return v.String(), nil
+}
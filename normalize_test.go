@@ -0,0 +1,65 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/semvertest"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		version     string
+		expected    string
+		expectedErr string
+	}{
+		{"1.2.3", "1.2.3", ""},
+		{"1.2.3-rc.1+build.5", "1.2.3-rc.1+build.5", ""},
+		{"1.2", "", "invalid version core: missing dot separator (at position 3)"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			s, err := semver.Normalize(test.version)
+			if err != nil {
+				if err.Error() != test.expectedErr {
+					t.Errorf("Expected error %q, got %q", test.expectedErr, err)
+				}
+				return
+			}
+			if s != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, s)
+			}
+		})
+	}
+}
+
+func FuzzNormalize(f *testing.F) {
+	for _, s := range semvertest.Corpus().Valid() {
+		f.Add(s)
+	}
+	for _, s := range semvertest.Corpus().Invalid() {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		normalized, err := semver.Normalize(s)
+		if err != nil {
+			return
+		}
+
+		again, err := semver.Normalize(normalized)
+		if err != nil {
+			t.Fatalf("Normalized version %q of %q is invalid: %v", normalized, s, err)
+		}
+		if again != normalized {
+			t.Errorf("Expected Normalize to be idempotent, got %q and %q for %q", normalized, again, s)
+		}
+
+		v := mustParse(t, s)
+		w := mustParse(t, normalized)
+		if !v.Equals(w) || v.Build != w.Build {
+			t.Errorf("Expected %q to round-trip, got %q", s, normalized)
+		}
+	})
+}
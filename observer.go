@@ -0,0 +1,33 @@
+package semver
+
+import (
+	"sync/atomic"
+)
+
+// ParseObserver receives the outcome of every parsed version, e.g. to count parses and failures as metrics.
+type ParseObserver interface {
+	// ObserveParse is called after a version was parsed with a nil error on success.
+	ObserveParse(err error)
+}
+
+type observerHolder struct {
+	observer ParseObserver
+}
+
+var parseObserver atomic.Pointer[observerHolder]
+
+// SetParseObserver sets the observer notified about all versions parsed by the package, nil removes it.
+// The observer is called synchronously and must be safe for concurrent use.
+func SetParseObserver(o ParseObserver) {
+	if o == nil {
+		parseObserver.Store(nil)
+		return
+	}
+	parseObserver.Store(&observerHolder{observer: o})
+}
+
+func observeParse(err error) {
+	if h := parseObserver.Load(); h != nil {
+		h.observer.ObserveParse(err)
+	}
+}
@@ -0,0 +1,47 @@
+package semver_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+type countingObserver struct {
+	mu       sync.Mutex
+	parses   int
+	failures int
+}
+
+func (o *countingObserver) ObserveParse(err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.parses++
+	if err != nil {
+		o.failures++
+	}
+}
+
+func TestSetParseObserver(t *testing.T) {
+	o := &countingObserver{}
+	semver.SetParseObserver(o)
+	defer semver.SetParseObserver(nil)
+
+	for _, s := range []string{"1.0.0", "1.0", "1.0.0-rc.1", "v1.0.0"} {
+		_, _ = semver.ParseVersion(s)
+	}
+	_, _ = semver.ParseVersionWithOptions("1:1.0.0", semver.ParseOptions{Epoch: true})
+
+	if o.parses != 5 {
+		t.Errorf("Expected 5 parses, got %d", o.parses)
+	}
+	if o.failures != 2 {
+		t.Errorf("Expected 2 failures, got %d", o.failures)
+	}
+
+	semver.SetParseObserver(nil)
+	_, _ = semver.ParseVersion("1.0.0")
+	if o.parses != 5 {
+		t.Errorf("Expected no more parses after removing the observer, got %d", o.parses)
+	}
+}
@@ -0,0 +1,20 @@
+package semver
+
+// Ordered wraps a version for ordered containers (e.g. B-trees or skip lists) that require the element type to have a
+// Less or Compare method.
+//
+// Versions are ordered by precedence, so versions only differing in build metadata are considered equal and replace
+// each other in containers with unique keys.
+type Ordered struct {
+	*Version
+}
+
+// Less determines if the version has a lower precedence than than.
+func (o Ordered) Less(than Ordered) bool {
+	return compareVersions(o.Version, than.Version) < 0
+}
+
+// Compare compares the version to other by precedence and returns -1, 0 or 1.
+func (o Ordered) Compare(other Ordered) int {
+	return compareVersions(o.Version, other.Version)
+}
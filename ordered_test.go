@@ -0,0 +1,43 @@
+package semver_test
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+// orderedContainer is a minimal container with the constraint of generic ordered container libraries.
+type orderedContainer[T interface {
+	Less(T) bool
+	Compare(T) int
+}] struct {
+	items []T
+}
+
+func (c *orderedContainer[T]) insert(item T) {
+	i := sort.Search(len(c.items), func(i int) bool { return !c.items[i].Less(item) })
+	if i < len(c.items) && c.items[i].Compare(item) == 0 {
+		c.items[i] = item
+		return
+	}
+	c.items = append(c.items, item)
+	copy(c.items[i+1:], c.items[i:])
+	c.items[i] = item
+}
+
+func TestOrdered(t *testing.T) {
+	var c orderedContainer[semver.Ordered]
+	for _, s := range []string{"1.10.0", "1.0.0-rc.1", "1.9.0", "1.0.0", "1.9.0+build"} {
+		c.insert(semver.Ordered{Version: mustParse(t, s)})
+	}
+
+	var got []string
+	for _, o := range c.items {
+		got = append(got, o.String())
+	}
+	if fmt.Sprint(got) != "[1.0.0-rc.1 1.0.0 1.9.0+build 1.10.0]" {
+		t.Errorf("Unexpected order %v", got)
+	}
+}
@@ -0,0 +1,93 @@
+package semver
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// Outlier is a suspicious version found by FindOutliers.
+type Outlier struct {
+	Version *Version
+	Reason  string
+}
+
+// FindOutliers flags versions that look like typos in a list of versions, e.g. 10.2.3 among 1.x versions or 1.2.30
+// after 1.2.3, to catch fat-fingered tags before they propagate.
+//
+// A major, minor or patch version is suspicious if it skips values compared to the lower values of the same series
+// and removing a single digit gives one of these values. This is a heuristic that can report false positives.
+// Outliers are returned in the order of the versions.
+func FindOutliers(vs []*Version) []Outlier {
+	reasons := make(map[*Version]string)
+
+	check := func(part string, group []*Version, value func(*Version) int) {
+		values := make(map[int]bool)
+		for _, v := range group {
+			values[value(v)] = true
+		}
+		sorted := make([]int, 0, len(values))
+		for n := range values {
+			sorted = append(sorted, n)
+		}
+		sort.Ints(sorted)
+
+		for i := 1; i < len(sorted); i++ {
+			n := sorted[i]
+			if n <= sorted[i-1]+1 {
+				continue
+			}
+			original, ok := digitTypoOf(n, sorted[:i])
+			if !ok {
+				continue
+			}
+			for _, v := range group {
+				if value(v) == n && reasons[v] == "" {
+					reasons[v] = fmt.Sprintf("%s version %d skips from %d, possible typo of %d", part, n, sorted[i-1], original)
+				}
+			}
+		}
+	}
+
+	check("major", vs, func(v *Version) int { return v.Major })
+	for _, group := range groupVersions(vs, func(v *Version) [2]int { return [2]int{v.Major, 0} }) {
+		check("minor", group, func(v *Version) int { return v.Minor })
+	}
+	for _, group := range groupVersions(vs, func(v *Version) [2]int { return [2]int{v.Major, v.Minor} }) {
+		check("patch", group, func(v *Version) int { return v.Patch })
+	}
+
+	var outliers []Outlier
+	for _, v := range vs {
+		if reason, ok := reasons[v]; ok {
+			outliers = append(outliers, Outlier{Version: v, Reason: reason})
+		}
+	}
+	return outliers
+}
+
+// digitTypoOf returns one of the candidates that equals n with a single digit removed.
+func digitTypoOf(n int, candidates []int) (int, bool) {
+	s := strconv.Itoa(n)
+	for i := range s {
+		m, err := strconv.Atoi(s[:i] + s[i+1:])
+		if err != nil {
+			continue
+		}
+		for _, c := range candidates {
+			if c == m {
+				return m, true
+			}
+		}
+	}
+	return 0, false
+}
+
+func groupVersions(vs []*Version, key func(*Version) [2]int) map[[2]int][]*Version {
+	groups := make(map[[2]int][]*Version)
+	for _, v := range vs {
+		k := key(v)
+		groups[k] = append(groups[k], v)
+	}
+	return groups
+}
@@ -0,0 +1,54 @@
+package semver_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestFindOutliers(t *testing.T) {
+	tests := []struct {
+		name     string
+		versions []string
+		expected string
+	}{
+		{
+			name:     "major typo",
+			versions: []string{"1.0.0", "1.1.0", "10.2.3", "1.2.0", "1.2.3"},
+			expected: "[10.2.3: major version 10 skips from 1, possible typo of 1]",
+		},
+		{
+			name:     "patch typo",
+			versions: []string{"1.2.2", "1.2.3", "1.2.30", "1.2.4"},
+			expected: "[1.2.30: patch version 30 skips from 4, possible typo of 3]",
+		},
+		{
+			name:     "minor typo",
+			versions: []string{"2.0.0", "2.1.0", "2.11.0", "3.0.0"},
+			expected: "[2.11.0: minor version 11 skips from 1, possible typo of 1]",
+		},
+		{
+			name:     "regular releases",
+			versions: []string{"1.0.0", "1.2.0", "1.9.0", "1.10.0", "1.11.0", "1.11.12", "2.0.0", "4.0.0"},
+			expected: "[]",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var vs []*semver.Version
+			for _, s := range test.versions {
+				vs = append(vs, mustParse(t, s))
+			}
+
+			var outliers []string
+			for _, o := range semver.FindOutliers(vs) {
+				outliers = append(outliers, fmt.Sprintf("%s: %s", o.Version, o.Reason))
+			}
+			if fmt.Sprint(outliers) != test.expected {
+				t.Errorf("Expected outliers %s, got %v", test.expected, outliers)
+			}
+		})
+	}
+}
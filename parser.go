@@ -78,14 +78,50 @@ Backus–Naur Form Grammar for Valid SemVer Versions
 type Parser struct {
 	input string
 	pos   int
+	opts  ParseOptions
 }
 
+// ParseOptions enable extensions of the SemVer syntax when parsing.
+type ParseOptions struct {
+	// Epoch allows an epoch prefix separated by a colon (e.g. "1:2.3.4") like in distribution package versions.
+	Epoch bool
+	// AllowVPrefix allows and ignores a leading "v" or "V" (e.g. "v1.2.3") like in Git tags.
+	AllowVPrefix bool
+}
+
+// NewParser returns a parser for the input with the default parse options, see SetDefaultParseOptions.
 func NewParser(input string) *Parser {
-	return &Parser{input: input, pos: 0}
+	return &Parser{input: input, pos: 0, opts: DefaultParseOptions()}
 }
 
 // ParseVersion parses a valid semantic version (<valid semver>)
 func (p *Parser) ParseVersion() (*Version, error) {
+	v, err := p.parseVersion()
+	observeParse(err)
+	return v, err
+}
+
+func (p *Parser) parseVersion() (*Version, error) {
+	if err := checkASCII(p.input[p.pos:]); err != nil {
+		return nil, err
+	}
+
+	if p.opts.AllowVPrefix && (p.match('v') || p.match('V')) {
+		p.pos++
+	}
+
+	var epoch int
+	if p.opts.Epoch && strings.IndexByte(p.input[p.pos:], ':') >= 0 {
+		var err error
+		epoch, err = p.parseNumericIdentifier()
+		if err != nil {
+			return nil, fmt.Errorf("invalid epoch: %w", err)
+		}
+		if !p.consume(':') {
+			return nil, newParseError(p.pos, KindMissingEpochColon)
+		}
+	}
+
 	major, minor, patch, err := p.parseVersionCore()
 	if err != nil {
 		return nil, fmt.Errorf("invalid version core: %w", err)
@@ -107,10 +143,11 @@ func (p *Parser) ParseVersion() (*Version, error) {
 		}
 	}
 	if p.pos < len(p.input) {
-		return nil, &ParseError{Position: p.pos, Message: fmt.Sprintf("unexpected trailing characters: %q", p.input[p.pos:])}
+		return nil, newParseError(p.pos, KindTrailingCharacters, p.input[p.pos:])
 	}
 
 	return &Version{
+		Epoch:      epoch,
 		Major:      major,
 		Minor:      minor,
 		Patch:      patch,
@@ -126,7 +163,7 @@ func (p *Parser) parseVersionCore() (major int, minor int, patch int, err error)
 	}
 
 	if !p.consume('.') {
-		return 0, 0, 0, &ParseError{Position: p.pos, Message: "missing dot separator"}
+		return 0, 0, 0, newParseError(p.pos, KindMissingDot)
 	}
 
 	minor, err = p.parseNumericIdentifier()
@@ -135,7 +172,7 @@ func (p *Parser) parseVersionCore() (major int, minor int, patch int, err error)
 	}
 
 	if !p.consume('.') {
-		return 0, 0, 0, &ParseError{Position: p.pos, Message: "missing dot separator"}
+		return 0, 0, 0, newParseError(p.pos, KindMissingDot)
 	}
 
 	patch, err = p.parseNumericIdentifier()
@@ -152,10 +189,7 @@ func (p *Parser) parseNumericIdentifier() (int, error) {
 
 		// Check if next character is a digit for better error messages
 		if p.matchDigit() {
-			return 0, &ParseError{
-				Position: p.pos - 1,
-				Message:  "leading zero is not allowed",
-			}
+			return 0, newParseError(p.pos-1, KindLeadingZero)
 		}
 
 		return 0, nil
@@ -172,7 +206,7 @@ func (p *Parser) parseNumericIdentifier() (int, error) {
 
 	num, err := strconv.Atoi(sb.String())
 	if err != nil {
-		return 0, err
+		return 0, newParseError(p.pos-sb.Len(), KindNumberOutOfRange, sb.String())
 	}
 
 	return num, nil
@@ -195,17 +229,11 @@ func (p *Parser) match(ch byte) bool {
 
 func (p *Parser) appendPositiveDigit(sb *strings.Builder) error {
 	if p.pos >= len(p.input) {
-		return &ParseError{
-			Position: p.pos,
-			Message:  "unexpected end of input",
-		}
+		return newParseError(p.pos, KindUnexpectedEnd)
 	}
 
 	if p.input[p.pos] < '1' || p.input[p.pos] > '9' {
-		return &ParseError{
-			Position: p.pos,
-			Message:  fmt.Sprintf("expected positive digit, got %c", p.input[p.pos]),
-		}
+		return newParseError(p.pos, KindExpectedDigit, string(p.input[p.pos]))
 	}
 
 	sb.WriteByte(p.input[p.pos])
@@ -256,10 +284,7 @@ func (p *Parser) parsePreRelease() (string, error) {
 
 func (p *Parser) appendAlphanumericIdentifier(sb *strings.Builder) error {
 	if p.pos >= len(p.input) {
-		return &ParseError{
-			Position: p.pos,
-			Message:  "unexpected end of input",
-		}
+		return newParseError(p.pos, KindUnexpectedEnd)
 	}
 
 	for p.matchLetter() || p.matchDigit() || p.match('-') {
@@ -268,10 +293,7 @@ func (p *Parser) appendAlphanumericIdentifier(sb *strings.Builder) error {
 	}
 
 	if sb.Len() == 0 {
-		return &ParseError{
-			Position: p.pos,
-			Message:  fmt.Sprintf("expected alphanumeric identifier, got %c", p.input[p.pos]),
-		}
+		return newParseError(p.pos, KindExpectedIdentifier, string(p.input[p.pos]))
 	}
 
 	return nil
@@ -0,0 +1,109 @@
+package semver
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// PartialVersion is a version with possibly omitted minor and patch versions, e.g. "1" or "1.2".
+//
+// Partial versions are ambiguous: "1.2" can mean the concrete version 1.2.0 or any 1.2.x version.
+// Instead of guessing, the caller chooses the interpretation with Version or Pattern.
+type PartialVersion struct {
+	Major int
+	Minor int
+	Patch int
+	// Parts is the number of given components of the version core (1 to 3).
+	Parts      int
+	PreRelease string
+	Build      string
+}
+
+// ParsePartial parses a version with one, two or three components. Pre-release and build metadata are only allowed
+// with all three components.
+func ParsePartial(version string) (*PartialVersion, error) {
+	if err := checkASCII(version); err != nil {
+		return nil, err
+	}
+
+	p := &Parser{input: version}
+	major, err := p.parseNumericIdentifier()
+	if err != nil {
+		return nil, fmt.Errorf("invalid version core: major: %w", err)
+	}
+	pv := &PartialVersion{Major: major, Parts: 1}
+
+	if p.consume('.') {
+		pv.Minor, err = p.parseNumericIdentifier()
+		if err != nil {
+			return nil, fmt.Errorf("invalid version core: minor: %w", err)
+		}
+		pv.Parts = 2
+
+		if p.match('.') {
+			v, err := ParseVersionWithOptions(version, ParseOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return &PartialVersion{
+				Major:      v.Major,
+				Minor:      v.Minor,
+				Patch:      v.Patch,
+				Parts:      3,
+				PreRelease: v.PreRelease,
+				Build:      v.Build,
+			}, nil
+		}
+	}
+
+	if p.pos < len(p.input) {
+		return nil, newParseError(p.pos, KindTrailingCharacters, p.input[p.pos:])
+	}
+	return pv, nil
+}
+
+// Version expands the partial version to a concrete version with omitted components set to zero (1.2 → 1.2.0).
+func (pv *PartialVersion) Version() *Version {
+	return &Version{
+		Major:      pv.Major,
+		Minor:      pv.Minor,
+		Patch:      pv.Patch,
+		PreRelease: pv.PreRelease,
+		Build:      pv.Build,
+	}
+}
+
+// Pattern expands the partial version to a pattern matching any value of the omitted components (1.2 → 1.2.*).
+// Like for every Pattern without a pre-release part, only versions without pre-release match.
+// A partial version with all three components matches only the exact version.
+func (pv *PartialVersion) Pattern() *Pattern {
+	p, err := CompilePattern(pv.patternSource())
+	if err != nil {
+		// The source is always a valid pattern, since it is built from valid components
+		panic(err)
+	}
+	return p
+}
+
+func (pv *PartialVersion) patternSource() string {
+	switch pv.Parts {
+	case 1:
+		return strconv.Itoa(pv.Major) + ".*"
+	case 2:
+		return fmt.Sprintf("%d.%d.*", pv.Major, pv.Minor)
+	default:
+		return pv.String()
+	}
+}
+
+// String returns the partial version as given, without expansion.
+func (pv *PartialVersion) String() string {
+	switch pv.Parts {
+	case 1:
+		return strconv.Itoa(pv.Major)
+	case 2:
+		return fmt.Sprintf("%d.%d", pv.Major, pv.Minor)
+	default:
+		return pv.Version().String()
+	}
+}
@@ -0,0 +1,75 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestParsePartial(t *testing.T) {
+	tests := []struct {
+		version         string
+		expectedVersion string
+		expectedPattern string
+		expectedErr     string
+	}{
+		{"1", "1.0.0", "1.*", ""},
+		{"1.2", "1.2.0", "1.2.*", ""},
+		{"1.2.3", "1.2.3", "1.2.3", ""},
+		{"1.2.3-rc.1+build", "1.2.3-rc.1+build", "1.2.3-rc.1+build", ""},
+		{"01.2", "", "", "invalid version core: major: leading zero is not allowed (at position 0)"},
+		{"1.", "", "", "invalid version core: minor: unexpected end of input (at position 2)"},
+		{"1.2-rc.1", "", "", `unexpected trailing characters: "-rc.1" (at position 3)`},
+		{"1.2.x", "", "", "invalid version core: patch: expected positive digit, got x (at position 4)"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			pv, err := semver.ParsePartial(test.version)
+			if err != nil {
+				if err.Error() != test.expectedErr {
+					t.Errorf("Expected error %q, got %q", test.expectedErr, err)
+				}
+				return
+			}
+			if test.expectedErr != "" {
+				t.Fatalf("Expected error %q, got nil", test.expectedErr)
+			}
+
+			if pv.String() != test.version {
+				t.Errorf("Expected string %q, got %q", test.version, pv.String())
+			}
+			if v := pv.Version().String(); v != test.expectedVersion {
+				t.Errorf("Expected version %q, got %q", test.expectedVersion, v)
+			}
+			if p := pv.Pattern().String(); p != test.expectedPattern {
+				t.Errorf("Expected pattern %q, got %q", test.expectedPattern, p)
+			}
+		})
+	}
+}
+
+func TestPartialVersion_Pattern(t *testing.T) {
+	pv, err := semver.ParsePartial("1.2")
+	if err != nil {
+		t.Fatalf("Error parsing partial version: %v", err)
+	}
+
+	tests := []struct {
+		version  string
+		expected bool
+	}{
+		{"1.2.0", true},
+		{"1.2.9", true},
+		{"1.3.0", false},
+		{"1.2.1-rc.1", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			if result := pv.Pattern().Match(mustParse(t, test.version)); result != test.expected {
+				t.Errorf("Expected %v, got %v", test.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package semver
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// FromParts returns a version from its components, e.g. for decoders of databases or protobuf messages that already
+// have the components and should not format and parse them again. Each part is validated according to the spec.
+func FromParts(major, minor, patch uint64, pre []string, build []string) (*Version, error) {
+	for _, part := range []struct {
+		name  string
+		value uint64
+	}{{"major", major}, {"minor", minor}, {"patch", patch}} {
+		if part.value > math.MaxInt {
+			return nil, fmt.Errorf("%s version %d is out of range", part.name, part.value)
+		}
+	}
+	for _, identifier := range pre {
+		if err := validateIdentifier(identifier, true); err != nil {
+			return nil, fmt.Errorf("invalid pre-release identifier %q: %w", identifier, err)
+		}
+	}
+	for _, identifier := range build {
+		if err := validateIdentifier(identifier, false); err != nil {
+			return nil, fmt.Errorf("invalid build identifier %q: %w", identifier, err)
+		}
+	}
+
+	return &Version{
+		Major:      int(major),
+		Minor:      int(minor),
+		Patch:      int(patch),
+		PreRelease: strings.Join(pre, "."),
+		Build:      strings.Join(build, "."),
+	}, nil
+}
+
+// validateIdentifier checks a pre-release (numeric identifiers must not have leading zeros) or build identifier.
+func validateIdentifier(identifier string, preRelease bool) error {
+	if identifier == "" {
+		return fmt.Errorf("identifier is empty")
+	}
+	numeric := true
+	for i := 0; i < len(identifier); i++ {
+		c := identifier[i]
+		switch {
+		case c >= '0' && c <= '9':
+		case c >= 'A' && c <= 'Z', c >= 'a' && c <= 'z', c == '-':
+			numeric = false
+		default:
+			return fmt.Errorf("character %q is not allowed", c)
+		}
+	}
+	if preRelease && numeric && len(identifier) > 1 && identifier[0] == '0' {
+		return fmt.Errorf("leading zero is not allowed")
+	}
+	return nil
+}
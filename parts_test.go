@@ -0,0 +1,47 @@
+package semver_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestFromParts(t *testing.T) {
+	tests := []struct {
+		name        string
+		major       uint64
+		minor       uint64
+		patch       uint64
+		pre         []string
+		build       []string
+		expected    string
+		expectedErr string
+	}{
+		{"release", 1, 2, 3, nil, nil, "1.2.3", ""},
+		{"pre-release and build", 1, 0, 0, []string{"rc", "1"}, []string{"sha", "5114f85"}, "1.0.0-rc.1+sha.5114f85", ""},
+		{"build with leading zero", 1, 0, 0, nil, []string{"001"}, "1.0.0+001", ""},
+		{"leading zero", 1, 0, 0, []string{"rc", "01"}, nil, "", `invalid pre-release identifier "01": leading zero is not allowed`},
+		{"empty identifier", 1, 0, 0, []string{""}, nil, "", `invalid pre-release identifier "": identifier is empty`},
+		{"invalid character", 1, 0, 0, nil, []string{"linux_amd64"}, "", `invalid build identifier "linux_amd64": character '_' is not allowed`},
+		{"out of range", math.MaxUint64, 0, 0, nil, nil, "", "major version 18446744073709551615 is out of range"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v, err := semver.FromParts(test.major, test.minor, test.patch, test.pre, test.build)
+			if err != nil {
+				if err.Error() != test.expectedErr {
+					t.Errorf("Expected error %q, got %q", test.expectedErr, err)
+				}
+				return
+			}
+			if test.expectedErr != "" {
+				t.Fatalf("Expected error %q, got nil", test.expectedErr)
+			}
+			if v.String() != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, v)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package semver
+
+import (
+	"path"
+	"strconv"
+	"strings"
+)
+
+// Pattern is a compiled glob pattern that is matched against the components of a version.
+//
+// A pattern is written like a version, but each part can contain the wildcards * (any sequence of characters) and
+// ? (any single character) or character classes like [0-9]:
+//
+//	1.2.*         matches 1.2.0 and 1.2.10, but not 1.2.0-rc.1
+//	1.*           matches all versions with major version 1 and without pre-release
+//	1.2.*-rc.*    matches 1.2.0-rc.1, but not 1.2.0-beta.1 or 1.2.0
+//	*.*.*-*       matches all versions
+//	1.2.3+*linux* matches 1.2.3+build.linux.amd64
+//
+// The major, minor and patch parts are matched separately against their decimal representation, a trailing *
+// matches all remaining parts. Without a pre-release part only versions without pre-release match, while the
+// pre-release and build parts are matched as a whole, so * matches an empty pre-release too.
+// Without a build part the build metadata is ignored.
+type Pattern struct {
+	source        string
+	core          []string
+	hasPreRelease bool
+	preRelease    string
+	hasBuild      bool
+	build         string
+}
+
+// CompilePattern compiles a pattern and returns a Pattern or an error if the pattern is invalid.
+func CompilePattern(pattern string) (*Pattern, error) {
+	p := &Pattern{source: pattern}
+
+	rest := pattern
+	if i := indexOutsideClass(rest, '+'); i >= 0 {
+		p.hasBuild = true
+		p.build = rest[i+1:]
+		rest = rest[:i]
+	}
+	if i := indexOutsideClass(rest, '-'); i >= 0 {
+		p.hasPreRelease = true
+		p.preRelease = rest[i+1:]
+		rest = rest[:i]
+	}
+
+	p.core = strings.Split(rest, ".")
+	if len(p.core) > 3 {
+		return nil, newParseError(len(strings.Join(p.core[:3], ".")), KindTooManyComponents)
+	}
+	if len(p.core) < 3 && p.core[len(p.core)-1] != "*" {
+		return nil, newParseError(len(rest), KindIncompletePattern)
+	}
+
+	pos := 0
+	for _, segment := range p.core {
+		if segment == "" {
+			return nil, newParseError(pos, KindEmptyComponent)
+		}
+		if _, err := path.Match(segment, ""); err != nil {
+			return nil, newParseError(pos, KindInvalidComponent, segment, err.Error())
+		}
+		pos += len(segment) + 1
+	}
+	if _, err := path.Match(p.preRelease, ""); p.hasPreRelease && err != nil {
+		return nil, newParseError(len(rest)+1, KindInvalidPreReleasePattern, p.preRelease, err.Error())
+	}
+	if _, err := path.Match(p.build, ""); p.hasBuild && err != nil {
+		return nil, newParseError(len(pattern)-len(p.build), KindInvalidBuildPattern, p.build, err.Error())
+	}
+
+	return p, nil
+}
+
+// MatchPattern determines if the version matches the pattern, see Pattern for the syntax.
+// It returns an error if the pattern is invalid.
+func MatchPattern(pattern string, v *Version) (bool, error) {
+	p, err := CompilePattern(pattern)
+	if err != nil {
+		return false, err
+	}
+	return p.Match(v), nil
+}
+
+// Match determines if the version matches the pattern.
+func (p *Pattern) Match(v *Version) bool {
+	components := []int{v.Major, v.Minor, v.Patch}
+	for i, segment := range p.core {
+		if i == len(p.core)-1 && segment == "*" {
+			break
+		}
+		if matched, _ := path.Match(segment, strconv.Itoa(components[i])); !matched {
+			return false
+		}
+	}
+
+	if !p.hasPreRelease {
+		if v.PreRelease != "" {
+			return false
+		}
+	} else if matched, _ := path.Match(p.preRelease, v.PreRelease); !matched {
+		return false
+	}
+
+	if p.hasBuild {
+		if matched, _ := path.Match(p.build, v.Build); !matched {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String returns the source of the pattern.
+func (p *Pattern) String() string {
+	return p.source
+}
+
+// indexOutsideClass returns the index of the first c in s that is not part of a character class, or -1.
+func indexOutsideClass(s string, c byte) int {
+	inClass := false
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\':
+			i++
+		case s[i] == '[':
+			inClass = true
+		case s[i] == ']':
+			inClass = false
+		case s[i] == c && !inClass:
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,74 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestMatchPattern(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		version  string
+		expected bool
+	}{
+		{"1.2.*", "1.2.0", true},
+		{"1.2.*", "1.2.10", true},
+		{"1.2.*", "1.3.0", false},
+		{"1.2.*", "1.2.0-rc.1", false},
+		{"1.2.*", "1.2.0+build", true},
+		{"1.*", "1.99.3", true},
+		{"1.*", "10.0.0", false},
+		{"1.?.0", "1.5.0", true},
+		{"1.?.0", "1.15.0", false},
+		{"1.[0-4].*", "1.4.2", true},
+		{"1.[0-4].*", "1.5.2", false},
+		{"1.2.*-rc.*", "1.2.0-rc.1", true},
+		{"1.2.*-rc.*", "1.2.0-beta.1", false},
+		{"1.2.*-rc.*", "1.2.0", false},
+		{"*.*.*-*", "1.2.0", true},
+		{"*.*.*-*", "0.0.1-alpha", true},
+		{"*-*", "3.0.0-beta", true},
+		{"1.2.3+*linux*", "1.2.3+build.linux.amd64", true},
+		{"1.2.3+*linux*", "1.2.3+build.darwin", false},
+		{"1.2.3+*linux*", "1.2.3", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.pattern+" with "+test.version, func(t *testing.T) {
+			result, err := semver.MatchPattern(test.pattern, mustParse(t, test.version))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result != test.expected {
+				t.Errorf("Expected %q to match %q to be %v, got %v", test.pattern, test.version, test.expected, result)
+			}
+		})
+	}
+}
+
+func TestCompilePattern_Errors(t *testing.T) {
+	tests := []struct {
+		pattern     string
+		expectedErr string
+	}{
+		{"1.2", "pattern must have three components or end with * (at position 3)"},
+		{"1.2.3.*", "too many components (at position 5)"},
+		{"1..*", "empty component (at position 2)"},
+		{"1.[2.*", `invalid component "[2": syntax error in pattern (at position 2)`},
+		{"1.2.*-[rc", `invalid pre-release "[rc": syntax error in pattern (at position 6)`},
+		{"1.2.*+[x", `invalid build "[x": syntax error in pattern (at position 6)`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.pattern, func(t *testing.T) {
+			_, err := semver.CompilePattern(test.pattern)
+			if err == nil {
+				t.Fatalf("Expected error %q, got nil", test.expectedErr)
+			}
+			if err.Error() != test.expectedErr {
+				t.Errorf("Expected error %q, got %q", test.expectedErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Package pkgmanifest helps generating the manifests of package managers like Homebrew (formulae and casks) and
+// Scoop (app manifests) from semantic versions.
+//
+// Version fields of these manifests are free-form strings, often taken from a tag ("v1.2.3") or with omitted
+// components ("1.2"). ParseVersion reads them leniently, FormatVersion renders a version for them and Latest selects
+// the newest of candidate strings like the livecheck of Homebrew or the checkver of Scoop.
+package pkgmanifest
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/networkteam/semver"
+)
+
+// ParseVersion parses the version field of a manifest. A leading "v" is stripped and omitted minor and patch
+// versions are completed with zeros, so "v1.2" is the version 1.2.0.
+func ParseVersion(s string) (*semver.Version, error) {
+	pv, err := semver.ParsePartial(strings.TrimPrefix(strings.TrimSpace(s), "v"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid manifest version %q: %w", s, err)
+	}
+	return pv.Version(), nil
+}
+
+// FormatVersion renders a version for the version field of a manifest. The build metadata is omitted, since the
+// package managers compare it like a part of the version. The epoch is omitted too, since the manifests have no epochs
+// (Homebrew orders versions across a version scheme change with version_scheme instead).
+func FormatVersion(v *semver.Version) string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.PreRelease != "" {
+		s += "-" + v.PreRelease
+	}
+	return s
+}
+
+// LatestOptions contains the options for Latest.
+type LatestOptions struct {
+	// Regex extracts the version from a candidate with its first capture group (or the whole match without groups),
+	// e.g. `^release-(\d+\.\d+\.\d+)$`. By default, the first version-like substring is used, which only recognizes
+	// common pre-release names like alpha, beta and rc.
+	Regex *regexp.Regexp
+	// IncludePreRelease also selects versions with a pre-release.
+	IncludePreRelease bool
+}
+
+// versionPattern finds version-like substrings, e.g. in tags, URLs or file names. Only common pre-release names are
+// recognized, so a suffix like "-linux.tar.gz" is not mistaken for a pre-release.
+var versionPattern = regexp.MustCompile(`\d+(?:\.\d+){0,2}(?:-(?:alpha|beta|rc|pre|preview|dev|next)[0-9A-Za-z]*(?:\.\d+)*)?`)
+
+// Latest returns the candidate (e.g. a tag or a file name of a download page) with the newest version and the
+// version. Candidates without a valid version are skipped. It returns false if no candidate has a version.
+func Latest(candidates []string, opts LatestOptions) (string, *semver.Version, bool) {
+	var latest string
+	var latestVersion *semver.Version
+	for _, c := range candidates {
+		v, ok := extractVersion(c, opts.Regex)
+		if !ok || v.PreRelease != "" && !opts.IncludePreRelease {
+			continue
+		}
+		if latestVersion == nil || latestVersion.Before(v) {
+			latest, latestVersion = c, v
+		}
+	}
+	return latest, latestVersion, latestVersion != nil
+}
+
+func extractVersion(candidate string, re *regexp.Regexp) (*semver.Version, bool) {
+	var s string
+	if re != nil {
+		match := re.FindStringSubmatch(candidate)
+		switch {
+		case match == nil:
+			return nil, false
+		case len(match) > 1:
+			s = match[1]
+		default:
+			s = match[0]
+		}
+	} else {
+		s = versionPattern.FindString(candidate)
+		// A pre-release is not allowed with omitted components, drop it instead of the candidate (e.g. "1.2-linux").
+		if core, _, found := strings.Cut(s, "-"); found && strings.Count(core, ".") < 2 {
+			s = core
+		}
+	}
+	v, err := ParseVersion(s)
+	return v, err == nil
+}
@@ -0,0 +1,78 @@
+package pkgmanifest_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/pkgmanifest"
+)
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		input       string
+		expected    string
+		expectedErr bool
+	}{
+		{"1.2.3", "1.2.3", false},
+		{"v1.2", "1.2.0", false},
+		{" 2 ", "2.0.0", false},
+		{"1.2.3-rc.1", "1.2.3-rc.1", false},
+		{"latest", "", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			v, err := pkgmanifest.ParseVersion(test.input)
+			if (err != nil) != test.expectedErr {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if err == nil && v.String() != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, v)
+			}
+		})
+	}
+}
+
+func TestFormatVersion(t *testing.T) {
+	v, err := semver.ParseVersion("1.2.3-rc.1+build.5")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if s := pkgmanifest.FormatVersion(v); s != "1.2.3-rc.1" {
+		t.Errorf("Expected %q, got %q", "1.2.3-rc.1", s)
+	}
+
+	v, err = semver.ParseVersionWithOptions("2:1.0.0+build.5", semver.ParseOptions{Epoch: true})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if s := pkgmanifest.FormatVersion(v); s != "1.0.0" {
+		t.Errorf("Expected %q, got %q", "1.0.0", s)
+	}
+}
+
+func TestLatest(t *testing.T) {
+	tags := []string{"v1.9.0", "v1.10.0", "v2.0.0-rc.1", "nightly", "release-1.10.1-linux.tar.gz", "v1.2"}
+
+	tests := []struct {
+		name     string
+		opts     pkgmanifest.LatestOptions
+		expected string
+	}{
+		{"default", pkgmanifest.LatestOptions{}, "release-1.10.1-linux.tar.gz 1.10.1"},
+		{"pre-releases", pkgmanifest.LatestOptions{IncludePreRelease: true}, "v2.0.0-rc.1 2.0.0-rc.1"},
+		{"regex", pkgmanifest.LatestOptions{Regex: regexp.MustCompile(`^v(\d+\.\d+\.\d+)$`)}, "v1.10.0 1.10.0"},
+		{"no match", pkgmanifest.LatestOptions{Regex: regexp.MustCompile(`^stable-`)}, " <nil>"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			candidate, v, _ := pkgmanifest.Latest(tags, test.opts)
+			if got := fmt.Sprintf("%s %v", candidate, v); got != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, got)
+			}
+		})
+	}
+}
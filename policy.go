@@ -0,0 +1,61 @@
+package semver
+
+import (
+	"regexp"
+)
+
+// Matcher is a predicate over versions. It is implemented by Expr, Pattern, Policy and Range.
+type Matcher interface {
+	Match(v *Version) bool
+}
+
+// MatchFunc adapts a function to a Matcher.
+type MatchFunc func(v *Version) bool
+
+// Match calls f(v).
+func (f MatchFunc) Match(v *Version) bool {
+	return f(v)
+}
+
+// MatchRegexp returns a Matcher that matches the string representation of a version (including the build metadata)
+// against a regular expression. This is meant for legacy rules, prefer Expr or Pattern for new ones.
+func MatchRegexp(re *regexp.Regexp) Matcher {
+	return MatchFunc(func(v *Version) bool {
+		return re.MatchString(v.String())
+	})
+}
+
+// Policy decides if versions are allowed based on rules of any kind of Matcher.
+//
+// A version is allowed if it matches at least one of the Include rules (or Include is empty)
+// and none of the Exclude rules.
+type Policy struct {
+	Include []Matcher
+	Exclude []Matcher
+}
+
+// Allows determines if the version is allowed by the policy.
+func (p Policy) Allows(v *Version) bool {
+	included := len(p.Include) == 0
+	for _, m := range p.Include {
+		if m.Match(v) {
+			included = true
+			break
+		}
+	}
+	if !included {
+		return false
+	}
+
+	for _, m := range p.Exclude {
+		if m.Match(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Match implements Matcher, it is the same as Allows.
+func (p Policy) Match(v *Version) bool {
+	return p.Allows(v)
+}
@@ -0,0 +1,54 @@
+package semver_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestPolicy(t *testing.T) {
+	expr, err := semver.CompileExpr(`major >= 2 && prerelease == ""`)
+	if err != nil {
+		t.Fatalf("Error compiling expression: %v", err)
+	}
+	pattern, err := semver.CompilePattern("1.9.*")
+	if err != nil {
+		t.Fatalf("Error compiling pattern: %v", err)
+	}
+
+	p := semver.Policy{
+		Include: []semver.Matcher{expr, pattern},
+		Exclude: []semver.Matcher{
+			semver.MatchRegexp(regexp.MustCompile(`^2\.1\.[0-3]$`)),
+			semver.MatchRegexp(regexp.MustCompile(`\+.*broken`)),
+		},
+	}
+
+	tests := []struct {
+		version  string
+		expected bool
+	}{
+		{"2.0.0", true},
+		{"2.1.2", false},
+		{"2.1.4", true},
+		{"2.2.0+build.broken", false},
+		{"1.9.3", true},
+		{"1.8.0", false},
+		{"3.0.0-rc.1", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			if result := p.Allows(mustParse(t, test.version)); result != test.expected {
+				t.Errorf("Expected Allows(%q) to be %v, got %v", test.version, test.expected, result)
+			}
+		})
+	}
+}
+
+func TestPolicy_Empty(t *testing.T) {
+	if !(semver.Policy{}).Allows(mustParse(t, "1.0.0")) {
+		t.Errorf("Expected empty policy to allow all versions")
+	}
+}
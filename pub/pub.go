@@ -0,0 +1,99 @@
+// Package pub parses version constraints of the Dart package manager pub, as used in pubspec.yaml.
+//
+// Supported constraints are "any", exact versions ("1.2.3"), caret syntax ("^1.2.3") and space-separated comparisons
+// (">=1.2.3 <2.0.0"). Like pub, the caret only allows changes up to the next breaking version, which is the next
+// minor version for versions before 1.0.0: "^0.1.2" is equivalent to ">=0.1.2 <0.2.0". An exclusive upper bound
+// that is not a pre-release excludes the pre-releases of the bound, so "<2.0.0" does not match 2.0.0-dev.1.
+package pub
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/networkteam/semver"
+)
+
+// Constraint is a parsed version constraint. It implements semver.Matcher.
+type Constraint struct {
+	source string
+	rng    semver.Range
+}
+
+// Parse parses a version constraint.
+func Parse(s string) (*Constraint, error) {
+	c := &Constraint{source: s}
+	s = strings.TrimSpace(s)
+	switch {
+	case s == "any":
+		return c, nil
+	case strings.HasPrefix(s, "^"):
+		v, err := semver.ParseVersionWithOptions(s[1:], semver.ParseOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("invalid version constraint %q: %w", c.source, err)
+		}
+		c.rng = semver.Range{Lower: v, LowerInclusive: true, Upper: excludePreReleases(nextBreaking(v))}
+		return c, nil
+	case s == "":
+		return nil, fmt.Errorf("invalid version constraint %q", c.source)
+	}
+
+	fields := strings.Fields(s)
+	for _, field := range fields {
+		op := field[:len(field)-len(strings.TrimLeft(field, "<>=~"))]
+		v, err := semver.ParseVersionWithOptions(field[len(op):], semver.ParseOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("invalid version constraint %q: %w", c.source, err)
+		}
+		var bound semver.Range
+		switch op {
+		case "":
+			if len(fields) > 1 {
+				return nil, fmt.Errorf("invalid version constraint %q: version %q must be the only comparison", c.source, field)
+			}
+			bound = semver.Range{Lower: v, LowerInclusive: true, Upper: v, UpperInclusive: true}
+		case ">=":
+			bound = semver.Range{Lower: v, LowerInclusive: true}
+		case ">":
+			bound = semver.Range{Lower: v}
+		case "<=":
+			bound = semver.Range{Upper: v, UpperInclusive: true}
+		case "<":
+			bound = semver.Range{Upper: excludePreReleases(v)}
+		default:
+			return nil, fmt.Errorf("invalid version constraint %q: unknown operator %q", c.source, op)
+		}
+		c.rng = c.rng.Intersect(bound)
+	}
+	return c, nil
+}
+
+// nextBreaking returns the next version with breaking changes according to pub.
+func nextBreaking(v *semver.Version) *semver.Version {
+	if v.Major == 0 {
+		return &semver.Version{Minor: v.Minor + 1}
+	}
+	return &semver.Version{Major: v.Major + 1}
+}
+
+// excludePreReleases returns the lowest pre-release of v as exclusive upper bound, unless v is a pre-release.
+func excludePreReleases(v *semver.Version) *semver.Version {
+	if v.PreRelease != "" {
+		return v
+	}
+	return &semver.Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch, PreRelease: "0"}
+}
+
+// Range returns the versions matched by the constraint. The range is unbounded for "any".
+func (c *Constraint) Range() semver.Range {
+	return c.rng
+}
+
+// Match determines if the version satisfies the constraint.
+func (c *Constraint) Match(v *semver.Version) bool {
+	return c.rng.Match(v)
+}
+
+// String returns the source of the constraint.
+func (c *Constraint) String() string {
+	return c.source
+}
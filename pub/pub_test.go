@@ -0,0 +1,67 @@
+package pub_test
+
+import (
+	"testing"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/pub"
+)
+
+func mustParse(t *testing.T, s string) *semver.Version {
+	t.Helper()
+	v, err := semver.ParseVersion(s)
+	if err != nil {
+		t.Fatalf("Error parsing version %q: %v", s, err)
+	}
+	return v
+}
+
+func TestConstraint_Match(t *testing.T) {
+	tests := []struct {
+		constraint  string
+		matching    []string
+		rejected    []string
+		expectedErr string
+	}{
+		{"any", []string{"0.0.1", "3.0.0-dev.1"}, nil, ""},
+		{"1.2.3", []string{"1.2.3", "1.2.3+1"}, []string{"1.2.4", "1.2.3-dev"}, ""},
+		{"^1.2.3", []string{"1.2.3", "1.9.0", "1.9.0-dev.1"}, []string{"1.2.2", "2.0.0-dev.1", "2.0.0"}, ""},
+		{"^0.1.2", []string{"0.1.2", "0.1.9"}, []string{"0.2.0-dev.1", "0.2.0", "1.0.0"}, ""},
+		{"^0.0.3", []string{"0.0.3", "0.0.9"}, []string{"0.0.2", "0.1.0"}, ""},
+		{">=1.2.3 <2.0.0", []string{"1.2.3", "1.99.0"}, []string{"1.2.2", "2.0.0-dev.1", "2.0.0"}, ""},
+		{">=2.12.0 <3.0.0-dev.1", []string{"3.0.0-dev.0"}, []string{"3.0.0-dev.1"}, ""},
+		{">1.0.0 <=1.5.0 >=1.2.0", []string{"1.2.0", "1.5.0"}, []string{"1.1.0", "1.5.1"}, ""},
+		{"", nil, nil, `invalid version constraint ""`},
+		{"^1.2", nil, nil, `invalid version constraint "^1.2": invalid version core: missing dot separator (at position 3)`},
+		{"~>1.2.3", nil, nil, `invalid version constraint "~>1.2.3": unknown operator "~>"`},
+		{"1.0.0 <2.0.0", nil, nil, `invalid version constraint "1.0.0 <2.0.0": version "1.0.0" must be the only comparison`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.constraint, func(t *testing.T) {
+			c, err := pub.Parse(test.constraint)
+			if err != nil {
+				if err.Error() != test.expectedErr {
+					t.Errorf("Expected error %q, got %q", test.expectedErr, err)
+				}
+				return
+			}
+			if test.expectedErr != "" {
+				t.Fatalf("Expected error %q, got nil", test.expectedErr)
+			}
+			if c.String() != test.constraint {
+				t.Errorf("Expected %q, got %q", test.constraint, c)
+			}
+			for _, s := range test.matching {
+				if !c.Match(mustParse(t, s)) {
+					t.Errorf("Expected %s to match", s)
+				}
+			}
+			for _, s := range test.rejected {
+				if c.Match(mustParse(t, s)) {
+					t.Errorf("Expected %s not to match", s)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package purl
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// AlpmVersion is an Arch Linux package version of the form [epoch:]pkgver[-pkgrel].
+type AlpmVersion struct {
+	Epoch   int
+	Version string
+	Release string
+}
+
+// ParseAlpmVersion parses an Arch Linux package version. Like pacman, a leading number followed by a colon is the
+// epoch and the part after the last dash is the release.
+func ParseAlpmVersion(version string) (*AlpmVersion, error) {
+	v := &AlpmVersion{Version: version}
+	digits := len(version) - len(strings.TrimLeft(version, "0123456789"))
+	if digits < len(version) && version[digits] == ':' {
+		if digits > 0 {
+			n, err := strconv.Atoi(version[:digits])
+			if err != nil {
+				return nil, fmt.Errorf("invalid alpm version %q: epoch is out of range", version)
+			}
+			v.Epoch = n
+		}
+		v.Version = version[digits+1:]
+	}
+	if i := strings.LastIndexByte(v.Version, '-'); i >= 0 {
+		v.Version, v.Release = v.Version[:i], v.Version[i+1:]
+	}
+	if v.Version == "" {
+		return nil, fmt.Errorf("invalid alpm version %q: empty pkgver", version)
+	}
+	return v, nil
+}
+
+// String returns the version in the form [epoch:]pkgver[-pkgrel].
+func (v *AlpmVersion) String() string {
+	s := v.Version
+	if v.Epoch != 0 {
+		s = strconv.Itoa(v.Epoch) + ":" + s
+	}
+	if v.Release != "" {
+		s += "-" + v.Release
+	}
+	return s
+}
+
+// Compare compares the version to other like pacman's vercmp and returns -1, 0 or 1.
+// Releases are only compared if both versions have one, so 1.0 equals 1.0-2.
+func (v *AlpmVersion) Compare(other *AlpmVersion) int {
+	if v.Epoch != other.Epoch {
+		return sign(v.Epoch - other.Epoch)
+	}
+	if result := rpmvercmp(v.Version, other.Version); result != 0 || v.Release == "" || other.Release == "" {
+		return result
+	}
+	return rpmvercmp(v.Release, other.Release)
+}
+
+// rpmvercmp compares alternating alphabetic and numeric segments like pacman, non-alphanumeric characters separate
+// segments.
+func rpmvercmp(a, b string) int {
+	if a == b {
+		return 0
+	}
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		segA, segB := i, j
+		for i < len(a) && !isAlnum(a[i]) {
+			i++
+		}
+		for j < len(b) && !isAlnum(b[j]) {
+			j++
+		}
+		if i == len(a) || j == len(b) {
+			break
+		}
+		// More separators sort after fewer, e.g. 1..0 is after 1.0.
+		if i-segA != j-segB {
+			return sign((i - segA) - (j - segB))
+		}
+
+		segA, segB = i, j
+		numeric := isDigit(a[i])
+		isSegment := isLetter
+		if numeric {
+			isSegment = isDigit
+		}
+		for i < len(a) && isSegment(a[i]) {
+			i++
+		}
+		for j < len(b) && isSegment(b[j]) {
+			j++
+		}
+		if j == segB {
+			// Segments of different types: numeric sorts after alphabetic.
+			if numeric {
+				return 1
+			}
+			return -1
+		}
+
+		sa, sb := a[segA:i], b[segB:j]
+		if numeric {
+			sa, sb = strings.TrimLeft(sa, "0"), strings.TrimLeft(sb, "0")
+			if len(sa) != len(sb) {
+				return sign(len(sa) - len(sb))
+			}
+		}
+		if result := strings.Compare(sa, sb); result != 0 {
+			return result
+		}
+	}
+
+	if i == len(a) && j == len(b) {
+		return 0
+	}
+	// A remaining alphabetic segment sorts before the end, e.g. 1.0alpha is before 1.0, other remainders after it.
+	if i == len(a) && !isLetterAt(b, j) || isLetterAt(a, i) {
+		return -1
+	}
+	return 1
+}
+
+func isLetter(c byte) bool {
+	return c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z'
+}
+
+func isLetterAt(s string, i int) bool {
+	return i < len(s) && isLetter(s[i])
+}
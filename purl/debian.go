@@ -0,0 +1,152 @@
+package purl
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DebianVersion is a Debian package version of the form [epoch:]upstream_version[-debian_revision].
+type DebianVersion struct {
+	Epoch    int
+	Upstream string
+	Revision string
+}
+
+// ParseDebianVersion parses a Debian package version.
+func ParseDebianVersion(version string) (*DebianVersion, error) {
+	v := &DebianVersion{}
+	rest := version
+	if epoch, after, found := strings.Cut(rest, ":"); found {
+		n, err := strconv.Atoi(epoch)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid debian version %q: epoch %q is not a number", version, epoch)
+		}
+		v.Epoch = n
+		rest = after
+	}
+	if i := strings.LastIndexByte(rest, '-'); i >= 0 {
+		v.Revision = rest[i+1:]
+		rest = rest[:i]
+		if v.Revision == "" {
+			return nil, fmt.Errorf("invalid debian version %q: empty revision", version)
+		}
+	}
+	v.Upstream = rest
+
+	if v.Upstream == "" || v.Upstream[0] < '0' || v.Upstream[0] > '9' {
+		return nil, fmt.Errorf("invalid debian version %q: upstream version must start with a digit", version)
+	}
+	for _, part := range []struct{ s, allowed string }{{v.Upstream, ".+~-"}, {v.Revision, ".+~"}} {
+		for i := 0; i < len(part.s); i++ {
+			c := part.s[i]
+			if !isAlnum(c) && !strings.ContainsRune(part.allowed, rune(c)) {
+				return nil, fmt.Errorf("invalid debian version %q: character %q is not allowed", version, c)
+			}
+		}
+	}
+	return v, nil
+}
+
+// String returns the version in the form [epoch:]upstream_version[-debian_revision].
+func (v *DebianVersion) String() string {
+	s := v.Upstream
+	if v.Epoch != 0 {
+		s = strconv.Itoa(v.Epoch) + ":" + s
+	}
+	if v.Revision != "" {
+		s += "-" + v.Revision
+	}
+	return s
+}
+
+// Compare compares the version to other like dpkg and returns -1, 0 or 1.
+// A tilde sorts before anything, even the end of a part, so 1.0~rc1 is before 1.0.
+func (v *DebianVersion) Compare(other *DebianVersion) int {
+	if v.Epoch != other.Epoch {
+		if v.Epoch < other.Epoch {
+			return -1
+		}
+		return 1
+	}
+	if result := compareDebianPart(v.Upstream, other.Upstream); result != 0 {
+		return result
+	}
+	return compareDebianPart(v.Revision, other.Revision)
+}
+
+// compareDebianPart compares alternating non-digit and digit sequences of upstream versions or revisions.
+func compareDebianPart(a, b string) int {
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		for i < len(a) && !isDigit(a[i]) || j < len(b) && !isDigit(b[j]) {
+			ac, bc := debianOrder(a, i), debianOrder(b, j)
+			if ac != bc {
+				return sign(ac - bc)
+			}
+			i++
+			j++
+		}
+
+		for i < len(a) && a[i] == '0' {
+			i++
+		}
+		for j < len(b) && b[j] == '0' {
+			j++
+		}
+		firstDiff := 0
+		for i < len(a) && isDigit(a[i]) && j < len(b) && isDigit(b[j]) {
+			if firstDiff == 0 {
+				firstDiff = int(a[i]) - int(b[j])
+			}
+			i++
+			j++
+		}
+		if i < len(a) && isDigit(a[i]) {
+			return 1
+		}
+		if j < len(b) && isDigit(b[j]) {
+			return -1
+		}
+		if firstDiff != 0 {
+			return sign(firstDiff)
+		}
+	}
+	return 0
+}
+
+// debianOrder returns the sort weight of the character at i (0 at the end of s).
+func debianOrder(s string, i int) int {
+	if i >= len(s) {
+		return 0
+	}
+	c := s[i]
+	switch {
+	case isDigit(c):
+		return 0
+	case c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z':
+		return int(c)
+	case c == '~':
+		return -1
+	default:
+		return int(c) + 256
+	}
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isAlnum(c byte) bool {
+	return isDigit(c) || c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z'
+}
+
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	}
+	return 0
+}
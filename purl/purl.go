@@ -0,0 +1,171 @@
+// Package purl parses and renders package URLs (purls) and the versions they contain.
+//
+// A package URL has the form
+//
+//	pkg:type/namespace/name@version?qualifiers#subpath
+//
+// The version is interpreted by the type of the package: npm, golang and cargo versions are semantic versions
+// (*semver.Version), deb versions are Debian versions (*DebianVersion) and alpm versions are Arch Linux versions
+// (*AlpmVersion).
+package purl
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// PURL is a parsed package URL.
+type PURL struct {
+	// Type is the package type in lower case, e.g. "npm" or "golang".
+	Type string
+	// Namespace is the optional namespace with segments separated by slashes, e.g. "github.com/networkteam".
+	Namespace string
+	// Name is the package name.
+	Name string
+	// Version is the optional version.
+	Version string
+	// Qualifiers are optional key-value pairs, e.g. the distribution of a deb package.
+	Qualifiers map[string]string
+	// Subpath is an optional path within the package.
+	Subpath string
+}
+
+// Parse parses a package URL.
+func Parse(s string) (*PURL, error) {
+	rest, ok := cutPrefixFold(s, "pkg:")
+	if !ok {
+		return nil, fmt.Errorf("invalid purl %q: missing pkg scheme", s)
+	}
+	rest = strings.TrimLeft(rest, "/")
+
+	p := &PURL{}
+	var err error
+	if i := strings.LastIndexByte(rest, '#'); i >= 0 {
+		p.Subpath = strings.Trim(rest[i+1:], "/")
+		rest = rest[:i]
+	}
+	if i := strings.LastIndexByte(rest, '?'); i >= 0 {
+		p.Qualifiers, err = parseQualifiers(rest[i+1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid purl %q: %w", s, err)
+		}
+		rest = rest[:i]
+	}
+
+	segments := strings.Split(strings.Trim(rest, "/"), "/")
+	if len(segments) < 2 || segments[0] == "" {
+		return nil, fmt.Errorf("invalid purl %q: missing type or name", s)
+	}
+	p.Type = strings.ToLower(segments[0])
+
+	last := segments[len(segments)-1]
+	if i := strings.LastIndexByte(last, '@'); i >= 0 {
+		if p.Version, err = url.PathUnescape(last[i+1:]); err != nil {
+			return nil, fmt.Errorf("invalid purl %q: version: %w", s, err)
+		}
+		last = last[:i]
+	}
+	if p.Name, err = url.PathUnescape(last); err != nil {
+		return nil, fmt.Errorf("invalid purl %q: name: %w", s, err)
+	}
+	if p.Name == "" {
+		return nil, fmt.Errorf("invalid purl %q: missing type or name", s)
+	}
+
+	namespace := make([]string, 0, len(segments)-2)
+	for _, segment := range segments[1 : len(segments)-1] {
+		unescaped, err := url.PathUnescape(segment)
+		if err != nil {
+			return nil, fmt.Errorf("invalid purl %q: namespace: %w", s, err)
+		}
+		namespace = append(namespace, unescaped)
+	}
+	p.Namespace = strings.Join(namespace, "/")
+
+	return p, nil
+}
+
+// String returns the canonical package URL.
+func (p *PURL) String() string {
+	var sb strings.Builder
+	sb.WriteString("pkg:")
+	sb.WriteString(p.Type)
+	sb.WriteByte('/')
+	if p.Namespace != "" {
+		for _, segment := range strings.Split(p.Namespace, "/") {
+			sb.WriteString(escape(segment))
+			sb.WriteByte('/')
+		}
+	}
+	sb.WriteString(escape(p.Name))
+	if p.Version != "" {
+		sb.WriteByte('@')
+		sb.WriteString(escape(p.Version))
+	}
+	if len(p.Qualifiers) > 0 {
+		keys := make([]string, 0, len(p.Qualifiers))
+		for k := range p.Qualifiers {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for i, k := range keys {
+			if i == 0 {
+				sb.WriteByte('?')
+			} else {
+				sb.WriteByte('&')
+			}
+			sb.WriteString(k)
+			sb.WriteByte('=')
+			sb.WriteString(escape(p.Qualifiers[k]))
+		}
+	}
+	if p.Subpath != "" {
+		sb.WriteByte('#')
+		sb.WriteString(p.Subpath)
+	}
+	return sb.String()
+}
+
+func parseQualifiers(s string) (map[string]string, error) {
+	qualifiers := make(map[string]string)
+	for _, pair := range strings.Split(s, "&") {
+		if pair == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok || k == "" {
+			return nil, fmt.Errorf("invalid qualifier %q", pair)
+		}
+		value, err := url.PathUnescape(v)
+		if err != nil {
+			return nil, fmt.Errorf("qualifier %q: %w", k, err)
+		}
+		qualifiers[strings.ToLower(k)] = value
+	}
+	return qualifiers, nil
+}
+
+// escape percent-encodes all characters except unreserved characters and colons.
+func escape(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9',
+			c == '.', c == '-', c == '_', c == '~', c == ':':
+			sb.WriteByte(c)
+		default:
+			fmt.Fprintf(&sb, "%%%02X", c)
+		}
+	}
+	return sb.String()
+}
+
+func cutPrefixFold(s, prefix string) (string, bool) {
+	if len(s) < len(prefix) || !strings.EqualFold(s[:len(prefix)], prefix) {
+		return s, false
+	}
+	return s[len(prefix):], true
+}
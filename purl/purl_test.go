@@ -0,0 +1,203 @@
+package purl_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/purl"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input       string
+		expected    string
+		canonical   string
+		expectedErr string
+	}{
+		{"pkg:npm/%40angular/core@16.2.0", "npm @angular core 16.2.0", "pkg:npm/%40angular/core@16.2.0", ""},
+		{"pkg:golang/github.com/networkteam/semver@v1.2.0", "golang github.com/networkteam semver v1.2.0", "pkg:golang/github.com/networkteam/semver@v1.2.0", ""},
+		{"pkg:cargo/serde@1.0.0%2Bbuild", "cargo  serde 1.0.0+build", "pkg:cargo/serde@1.0.0%2Bbuild", ""},
+		{"PKG:DEB/debian/openssl@1:3.0.11-1~deb12u2?distro=bookworm&arch=amd64", "deb debian openssl 1:3.0.11-1~deb12u2", "pkg:deb/debian/openssl@1:3.0.11-1~deb12u2?arch=amd64&distro=bookworm", ""},
+		{"pkg:npm/left-pad#lib/index.js", "npm  left-pad ", "pkg:npm/left-pad#lib/index.js", ""},
+		{"npm/left-pad@1.0.0", "", "", `invalid purl "npm/left-pad@1.0.0": missing pkg scheme`},
+		{"pkg:npm", "", "", `invalid purl "pkg:npm": missing type or name`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			p, err := purl.Parse(test.input)
+			if err != nil {
+				if err.Error() != test.expectedErr {
+					t.Errorf("Expected error %q, got %q", test.expectedErr, err)
+				}
+				return
+			}
+			if test.expectedErr != "" {
+				t.Fatalf("Expected error %q, got nil", test.expectedErr)
+			}
+			if got := fmt.Sprintf("%s %s %s %s", p.Type, p.Namespace, p.Name, p.Version); got != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, got)
+			}
+			if p.String() != test.canonical {
+				t.Errorf("Expected canonical %q, got %q", test.canonical, p)
+			}
+		})
+	}
+}
+
+func TestParsedVersion(t *testing.T) {
+	tests := []struct {
+		input       string
+		expected    string
+		expectedErr string
+	}{
+		{"pkg:npm/left-pad@v1.3.0", "*semver.Version 1.3.0", ""},
+		{"pkg:golang/golang.org/x/text@v0.14.0", "*semver.Version 0.14.0", ""},
+		{"pkg:cargo/serde@1.0.197", "*semver.Version 1.0.197", ""},
+		{"pkg:deb/debian/openssl@3.0.11-1~deb12u2", "*purl.DebianVersion 3.0.11-1~deb12u2", ""},
+		{"pkg:alpm/arch/pacman@1:6.0.2-9", "*purl.AlpmVersion 1:6.0.2-9", ""},
+		{"pkg:golang/golang.org/x/text@0.14.0", "", `go module version "0.14.0" has no v prefix`},
+		{"pkg:npm/left-pad@1.3", "", "invalid version core: missing dot separator (at position 3)"},
+		{"pkg:deb/debian/openssl@x:1.0", "", `invalid debian version "x:1.0": epoch "x" is not a number`},
+		{"pkg:maven/org.apache/commons@1.0", "", `unsupported package type "maven"`},
+		{"pkg:npm/left-pad", "", "purl has no version"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			p, err := purl.Parse(test.input)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			v, err := p.ParsedVersion()
+			if err != nil {
+				if err.Error() != test.expectedErr {
+					t.Errorf("Expected error %q, got %q", test.expectedErr, err)
+				}
+				if v != nil {
+					t.Errorf("Expected nil version on error, got %#v", v)
+				}
+				return
+			}
+			if got := fmt.Sprintf("%T %s", v, v); got != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestFromVersion(t *testing.T) {
+	v, err := semver.ParseVersion("1.2.0-rc.1+build.5")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if s := purl.FromVersion("golang", "github.com/networkteam", "semver", v).String(); s != "pkg:golang/github.com/networkteam/semver@v1.2.0-rc.1%2Bbuild.5" {
+		t.Errorf("Unexpected purl %q", s)
+	}
+	if s := purl.FromVersion("npm", "@angular", "core", v).String(); s != "pkg:npm/%40angular/core@1.2.0-rc.1%2Bbuild.5" {
+		t.Errorf("Unexpected purl %q", s)
+	}
+}
+
+func TestDebianVersion_Compare(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"1.0", "1.0", 0},
+		{"1.0~rc1", "1.0", -1},
+		{"1.0", "1.0+b1", -1},
+		{"1.0-1", "1.0-2", -1},
+		{"1.10", "1.9", 1},
+		{"1:0.9", "2.0", 1},
+		{"1.0a", "1.0-1", 1},
+		{"2.30-1", "2.3-1", 1},
+		{"1.001", "1.1", 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.a+" <=> "+test.b, func(t *testing.T) {
+			a, err := purl.ParseDebianVersion(test.a)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			b, err := purl.ParseDebianVersion(test.b)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result := a.Compare(b); result != test.expected {
+				t.Errorf("Expected %d, got %d", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestParseDebianVersion_Invalid(t *testing.T) {
+	for _, input := range []string{"", "a1.0", "x:1.0", "1.0-", "1.0_1"} {
+		if _, err := purl.ParseDebianVersion(input); err == nil {
+			t.Errorf("Expected error for %q", input)
+		}
+	}
+}
+
+func TestAlpmVersion_Compare(t *testing.T) {
+	// Cases from the vercmp tests of pacman.
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"1.5.0", "1.5.0", 0},
+		{"1.5.1", "1.5.0", 1},
+		{"1.5.1", "1.5", 1},
+		{"1.5.0-1", "1.5.0-2", -1},
+		{"1.5.0-2", "1.5.1-1", -1},
+		{"1.5-1", "1.5", 0},
+		{"1.0a", "1.0alpha", -1},
+		{"1.0alpha", "1.0b", -1},
+		{"1.0pre", "1.0rc", -1},
+		{"1.0rc", "1.0", -1},
+		{"1.0", "1.0.a", -1},
+		{"1.0.a", "1.0.1", -1},
+		{"1.0..0", "1.0.0", 1},
+		{"1.010", "1.9", 1},
+		{"1.001", "1.1", 0},
+		{"0:1.0", "1.0", 0},
+		{"1:1.0", "2.0", 1},
+		{"1:1.0-1", "1:1.0-2", -1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.a+" <=> "+test.b, func(t *testing.T) {
+			a, err := purl.ParseAlpmVersion(test.a)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			b, err := purl.ParseAlpmVersion(test.b)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result := a.Compare(b); result != test.expected {
+				t.Errorf("Expected %d, got %d", test.expected, result)
+			}
+			if result := b.Compare(a); result != -test.expected {
+				t.Errorf("Expected %d for reversed arguments, got %d", -test.expected, result)
+			}
+		})
+	}
+}
+
+func TestParseAlpmVersion(t *testing.T) {
+	v, err := purl.ParseAlpmVersion("2:1.2.3-4-5")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if v.Epoch != 2 || v.Version != "1.2.3-4" || v.Release != "5" || v.String() != "2:1.2.3-4-5" {
+		t.Errorf("Unexpected version %+v", v)
+	}
+	for _, input := range []string{"", "1:", "-1"} {
+		if _, err := purl.ParseAlpmVersion(input); err == nil {
+			t.Errorf("Expected error for %q", input)
+		}
+	}
+}
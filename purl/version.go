@@ -0,0 +1,73 @@
+package purl
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/networkteam/semver"
+)
+
+// Version is a version parsed with the dialect of a package type, it is a *semver.Version, a *DebianVersion or an
+// *AlpmVersion.
+type Version interface {
+	String() string
+}
+
+// ParseVersion parses a version with the dialect of the package type:
+//
+//	npm     semantic version, a leading "v" or "=" is stripped
+//	golang  semantic version with a required "v" prefix
+//	cargo   semantic version
+//	deb     Debian version
+//	alpm    Arch Linux version
+//
+// It returns an error for other types.
+func ParseVersion(typ, version string) (Version, error) {
+	switch strings.ToLower(typ) {
+	case "npm":
+		return asVersion(semver.ParseVersionWithOptions(strings.TrimLeft(version, "v="), semver.ParseOptions{}))
+	case "golang":
+		if !strings.HasPrefix(version, "v") {
+			return nil, fmt.Errorf("go module version %q has no v prefix", version)
+		}
+		return asVersion(semver.ParseVersionWithOptions(version[1:], semver.ParseOptions{}))
+	case "cargo":
+		return asVersion(semver.ParseVersionWithOptions(version, semver.ParseOptions{}))
+	case "deb":
+		return asVersion(ParseDebianVersion(version))
+	case "alpm":
+		return asVersion(ParseAlpmVersion(version))
+	}
+	return nil, fmt.Errorf("unsupported package type %q", typ)
+}
+
+// asVersion returns a parsed version as Version, with a nil interface instead of a typed nil pointer on error.
+func asVersion[V Version](v V, err error) (Version, error) {
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// errNoVersion is returned by ParsedVersion for a package URL without version.
+var errNoVersion = errors.New("purl has no version")
+
+// ParsedVersion parses the version of the package URL with ParseVersion.
+func (p *PURL) ParsedVersion() (Version, error) {
+	if p.Version == "" {
+		return nil, errNoVersion
+	}
+	return ParseVersion(p.Type, p.Version)
+}
+
+// FromVersion returns a package URL for a semantic version of a package of the given type, namespace and name.
+// The version is rendered in the dialect of the type, e.g. with a "v" prefix for golang.
+func FromVersion(typ, namespace, name string, v *semver.Version) *PURL {
+	typ = strings.ToLower(typ)
+	version := v.String()
+	if typ == "golang" {
+		version = "v" + version
+	}
+	return &PURL{Type: typ, Namespace: namespace, Name: name, Version: version}
+}
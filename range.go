@@ -0,0 +1,188 @@
+package semver
+
+import (
+	"slices"
+	"strings"
+)
+
+// Range is an interval of versions by precedence, e.g. the affected versions of an advisory.
+// A nil bound is unbounded.
+type Range struct {
+	Lower          *Version
+	LowerInclusive bool
+	Upper          *Version
+	UpperInclusive bool
+}
+
+// Match determines if the version is within the range.
+func (r Range) Match(v *Version) bool {
+	if r.Lower != nil {
+		result := compareVersions(v, r.Lower)
+		if result < 0 || result == 0 && !r.LowerInclusive {
+			return false
+		}
+	}
+	if r.Upper != nil {
+		result := compareVersions(v, r.Upper)
+		if result > 0 || result == 0 && !r.UpperInclusive {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the range as the source of an equivalent Expr, e.g. `version >= "1.0.0" && version < "2.0.0"`.
+func (r Range) String() string {
+	var comparisons []string
+	if r.Lower != nil {
+		op := ">"
+		if r.LowerInclusive {
+			op = ">="
+		}
+		comparisons = append(comparisons, versionComparison{op: op, value: r.Lower}.String())
+	}
+	if r.Upper != nil {
+		op := "<"
+		if r.UpperInclusive {
+			op = "<="
+		}
+		comparisons = append(comparisons, versionComparison{op: op, value: r.Upper}.String())
+	}
+	if len(comparisons) == 0 {
+		return "major >= 0"
+	}
+	return strings.Join(comparisons, " && ")
+}
+
+// IsEmpty determines if no version is within the range, e.g. for a lower bound above the upper bound.
+func (r Range) IsEmpty() bool {
+	if r.Lower == nil || r.Upper == nil {
+		return false
+	}
+	result := compareVersions(r.Lower, r.Upper)
+	return result > 0 || result == 0 && !(r.LowerInclusive && r.UpperInclusive)
+}
+
+// MergeRanges simplifies a union of ranges: it returns the union as ranges sorted by their lower bound, with empty
+// ranges removed and overlapping or adjacent ranges merged, e.g. 1.0.0 to 1.5.0 and 1.4.0 to 2.0.0 to 1.0.0 to 2.0.0.
+// A version is within a returned range if and only if it is within one of the given ranges.
+func MergeRanges(ranges []Range) []Range {
+	sorted := make([]Range, 0, len(ranges))
+	for _, r := range ranges {
+		if !r.IsEmpty() {
+			sorted = append(sorted, r)
+		}
+	}
+	slices.SortStableFunc(sorted, compareLowerBounds)
+
+	var merged []Range
+	for _, r := range sorted {
+		if len(merged) == 0 {
+			merged = append(merged, r)
+			continue
+		}
+		last := &merged[len(merged)-1]
+		if !connected(*last, r) {
+			merged = append(merged, r)
+			continue
+		}
+		if compareUpperBounds(r, *last) > 0 {
+			last.Upper, last.UpperInclusive = r.Upper, r.UpperInclusive
+		}
+	}
+	return merged
+}
+
+// Intersect returns the range of versions that are within both ranges, it is empty if the ranges don't overlap.
+func (r Range) Intersect(other Range) Range {
+	if compareLowerBounds(other, r) > 0 {
+		r.Lower, r.LowerInclusive = other.Lower, other.LowerInclusive
+	}
+	if compareUpperBounds(other, r) < 0 {
+		r.Upper, r.UpperInclusive = other.Upper, other.UpperInclusive
+	}
+	return r
+}
+
+// intersectRanges returns the ranges of versions that are within a range of both a and b.
+func intersectRanges(a, b []Range) []Range {
+	var result []Range
+	for _, ra := range a {
+		for _, rb := range b {
+			result = append(result, ra.Intersect(rb))
+		}
+	}
+	return MergeRanges(result)
+}
+
+// complementRanges returns the ranges of versions that are not within any of the ranges.
+func complementRanges(ranges []Range) []Range {
+	var result []Range
+	gap := Range{}
+	for _, r := range MergeRanges(ranges) {
+		if r.Lower != nil {
+			gap.Upper, gap.UpperInclusive = r.Lower, !r.LowerInclusive
+			result = append(result, gap)
+		}
+		if r.Upper == nil {
+			return MergeRanges(result)
+		}
+		gap = Range{Lower: r.Upper, LowerInclusive: !r.UpperInclusive}
+	}
+	return MergeRanges(append(result, gap))
+}
+
+// compareLowerBounds compares the lower bounds of two ranges, a nil bound is the lowest and an inclusive bound is
+// lower than an exclusive bound of the same version.
+func compareLowerBounds(a, b Range) int {
+	switch {
+	case a.Lower == nil && b.Lower == nil:
+		return 0
+	case a.Lower == nil:
+		return -1
+	case b.Lower == nil:
+		return 1
+	}
+	if result := compareVersions(a.Lower, b.Lower); result != 0 {
+		return result
+	}
+	return compareBools(b.LowerInclusive, a.LowerInclusive)
+}
+
+// compareUpperBounds compares the upper bounds of two ranges, a nil bound is the highest and an inclusive bound is
+// higher than an exclusive bound of the same version.
+func compareUpperBounds(a, b Range) int {
+	switch {
+	case a.Upper == nil && b.Upper == nil:
+		return 0
+	case a.Upper == nil:
+		return 1
+	case b.Upper == nil:
+		return -1
+	}
+	if result := compareVersions(a.Upper, b.Upper); result != 0 {
+		return result
+	}
+	return compareBools(a.UpperInclusive, b.UpperInclusive)
+}
+
+// connected determines if the range b, which doesn't start before a, overlaps a or is adjacent to it, so their union
+// is a single range.
+func connected(a, b Range) bool {
+	if a.Upper == nil || b.Lower == nil {
+		return true
+	}
+	result := compareVersions(b.Lower, a.Upper)
+	return result < 0 || result == 0 && (a.UpperInclusive || b.LowerInclusive)
+}
+
+func compareBools(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	default:
+		return -1
+	}
+}
@@ -0,0 +1,170 @@
+package semver_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		r        semver.Range
+		source   string
+		matching []string
+		rejected []string
+	}{
+		{
+			"half-open",
+			semver.Range{Lower: mustParse(t, "1.0.0"), LowerInclusive: true, Upper: mustParse(t, "2.0.0")},
+			`version >= "1.0.0" && version < "2.0.0"`,
+			[]string{"1.0.0", "1.9.9", "2.0.0-rc.1"},
+			[]string{"1.0.0-rc.1", "2.0.0"},
+		},
+		{
+			"exclusive lower, inclusive upper",
+			semver.Range{Lower: mustParse(t, "1.0.0"), Upper: mustParse(t, "1.2.0"), UpperInclusive: true},
+			`version > "1.0.0" && version <= "1.2.0"`,
+			[]string{"1.0.1", "1.2.0", "1.2.0+build"},
+			[]string{"1.0.0", "1.2.1"},
+		},
+		{
+			"unbounded",
+			semver.Range{},
+			`major >= 0`,
+			[]string{"0.0.0-alpha", "99.0.0"},
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expr, err := semver.CompileExpr(test.r.String())
+			if err != nil {
+				t.Fatalf("Error compiling %q: %v", test.r, err)
+			}
+			if test.r.String() != test.source {
+				t.Errorf("Expected %q, got %q", test.source, test.r)
+			}
+			for _, s := range test.matching {
+				v := mustParse(t, s)
+				if !test.r.Match(v) || !expr.Match(v) {
+					t.Errorf("Expected %s to match", s)
+				}
+			}
+			for _, s := range test.rejected {
+				v := mustParse(t, s)
+				if test.r.Match(v) || expr.Match(v) {
+					t.Errorf("Expected %s not to match", s)
+				}
+			}
+		})
+	}
+}
+
+func TestRange_Epoch(t *testing.T) {
+	opts := semver.ParseOptions{Epoch: true}
+	lower, _ := semver.ParseVersionWithOptions("1:2.0.0", opts)
+	r := semver.Range{Lower: lower, LowerInclusive: true}
+
+	expr, err := semver.CompileExpr(r.String())
+	if err != nil {
+		t.Fatalf("Error compiling %q: %v", r, err)
+	}
+	tests := []struct {
+		version  string
+		expected bool
+	}{
+		{"1:2.0.0", true},
+		{"2:0.1.0", true},
+		{"1:1.9.9", false},
+		{"3.0.0", false},
+	}
+	for _, test := range tests {
+		v, _ := semver.ParseVersionWithOptions(test.version, opts)
+		if result := expr.Match(v); result != test.expected || r.Match(v) != test.expected {
+			t.Errorf("Expected %q to match %s to be %v, got %v", r, test.version, test.expected, result)
+		}
+	}
+}
+
+func TestRange_IsEmpty(t *testing.T) {
+	tests := []struct {
+		r        semver.Range
+		expected bool
+	}{
+		{semver.Range{}, false},
+		{semver.Range{Lower: mustParse(t, "1.0.0"), LowerInclusive: true, Upper: mustParse(t, "1.0.0"), UpperInclusive: true}, false},
+		{semver.Range{Lower: mustParse(t, "1.0.0"), LowerInclusive: true, Upper: mustParse(t, "1.0.0")}, true},
+		{semver.Range{Lower: mustParse(t, "2.0.0"), Upper: mustParse(t, "1.0.0")}, true},
+	}
+
+	for _, test := range tests {
+		if result := test.r.IsEmpty(); result != test.expected {
+			t.Errorf("Expected IsEmpty of %s to be %v, got %v", test.r, test.expected, result)
+		}
+	}
+}
+
+func TestMergeRanges(t *testing.T) {
+	halfOpen := func(lower, upper string) semver.Range {
+		r := semver.Range{LowerInclusive: true}
+		if lower != "" {
+			r.Lower = mustParse(t, lower)
+		}
+		if upper != "" {
+			r.Upper = mustParse(t, upper)
+		}
+		return r
+	}
+
+	tests := []struct {
+		name     string
+		ranges   []semver.Range
+		expected []string
+	}{
+		{
+			"overlapping",
+			[]semver.Range{halfOpen("1.4.0", "2.0.0"), halfOpen("1.0.0", "1.5.0")},
+			[]string{`version >= "1.0.0" && version < "2.0.0"`},
+		},
+		{
+			"adjacent",
+			[]semver.Range{halfOpen("1.0.0", "1.5.0"), halfOpen("1.5.0", "2.0.0")},
+			[]string{`version >= "1.0.0" && version < "2.0.0"`},
+		},
+		{
+			"not adjacent at exclusive bounds",
+			[]semver.Range{halfOpen("1.0.0", "1.5.0"), {Lower: mustParse(t, "1.5.0"), Upper: mustParse(t, "2.0.0")}},
+			[]string{`version >= "1.0.0" && version < "1.5.0"`, `version > "1.5.0" && version < "2.0.0"`},
+		},
+		{
+			"contained",
+			[]semver.Range{halfOpen("1.0.0", "3.0.0"), halfOpen("1.5.0", "2.0.0")},
+			[]string{`version >= "1.0.0" && version < "3.0.0"`},
+		},
+		{
+			"disjoint",
+			[]semver.Range{halfOpen("3.0.0", ""), halfOpen("", "1.0.0")},
+			[]string{`version < "1.0.0"`, `version >= "3.0.0"`},
+		},
+		{
+			"empty",
+			[]semver.Range{halfOpen("2.0.0", "1.0.0"), halfOpen("1.0.0", "1.0.0")},
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var result []string
+			for _, r := range semver.MergeRanges(test.ranges) {
+				result = append(result, r.String())
+			}
+			if !slices.Equal(result, test.expected) {
+				t.Errorf("Expected %q, got %q", test.expected, result)
+			}
+		})
+	}
+}
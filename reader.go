@@ -0,0 +1,31 @@
+package semver
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// InputTooLongError is returned by ParseFromReader if the input exceeds the limit.
+type InputTooLongError struct {
+	// Limit is the maximum number of bytes.
+	Limit int
+}
+
+func (e *InputTooLongError) Error() string {
+	return fmt.Sprintf("input exceeds the limit of %d bytes", e.Limit)
+}
+
+// ParseFromReader reads a version of at most limit bytes from the reader and parses it like ParseVersion, e.g. from
+// a request body. Surrounding whitespace like a trailing newline is ignored, but counts towards the limit.
+// It returns an *InputTooLongError if the reader has more than limit bytes, without reading more than limit+1 bytes.
+func ParseFromReader(r io.Reader, limit int) (*Version, error) {
+	data, err := io.ReadAll(io.LimitReader(r, int64(limit)+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > limit {
+		return nil, &InputTooLongError{Limit: limit}
+	}
+	return ParseVersion(strings.TrimSpace(string(data)))
+}
@@ -0,0 +1,56 @@
+package semver_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/networkteam/semver"
+)
+
+func TestParseFromReader(t *testing.T) {
+	tests := []struct {
+		input       string
+		limit       int
+		expected    string
+		expectedErr string
+	}{
+		{"1.2.3-rc.1+build.5", 64, "1.2.3-rc.1+build.5", ""},
+		{"1.2.3\n", 6, "1.2.3", ""},
+		{"1.2.3\n", 5, "", "input exceeds the limit of 5 bytes"},
+		{"1.2.3-" + strings.Repeat("a", 1<<20), 256, "", "input exceeds the limit of 256 bytes"},
+		{"1.2", 64, "", "invalid version core: missing dot separator (at position 3)"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input[:min(len(test.input), 20)], func(t *testing.T) {
+			v, err := semver.ParseFromReader(strings.NewReader(test.input), test.limit)
+			if err != nil {
+				if err.Error() != test.expectedErr {
+					t.Errorf("Expected error %q, got %q", test.expectedErr, err)
+				}
+				return
+			}
+			if test.expectedErr != "" {
+				t.Fatalf("Expected error %q, got nil", test.expectedErr)
+			}
+			if v.String() != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, v)
+			}
+		})
+	}
+}
+
+func TestParseFromReader_Errors(t *testing.T) {
+	_, err := semver.ParseFromReader(strings.NewReader("1.2.3-rc.1"), 4)
+	var tooLong *semver.InputTooLongError
+	if !errors.As(err, &tooLong) || tooLong.Limit != 4 {
+		t.Errorf("Expected *InputTooLongError with limit 4, got %v", err)
+	}
+
+	readErr := errors.New("connection reset")
+	if _, err := semver.ParseFromReader(iotest.ErrReader(readErr), 64); !errors.Is(err, readErr) {
+		t.Errorf("Expected read error, got %v", err)
+	}
+}
@@ -0,0 +1,44 @@
+package semver
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// ErrNoRelease is returned by LatestRelease if no tag is a matching release.
+var ErrNoRelease = errors.New("no matching release found")
+
+// TagProvider lists the tags of a repository, e.g. from the API of a code hosting service.
+type TagProvider interface {
+	ListTags(ctx context.Context) ([]string, error)
+}
+
+// LatestRelease returns the release with the highest precedence of the tags listed by the provider.
+//
+// Tags are parsed as versions with an optional "v" prefix, other tags and versions with a pre-release are skipped.
+// If m is not nil, only versions matching m are considered. ErrNoRelease is returned if no tag is a matching release.
+func LatestRelease(ctx context.Context, p TagProvider, m Matcher) (*Version, error) {
+	tags, err := p.ListTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *Version
+	for _, tag := range tags {
+		v, err := ParseVersionWithOptions(strings.TrimPrefix(tag, "v"), ParseOptions{})
+		if err != nil || v.PreRelease != "" {
+			continue
+		}
+		if m != nil && !m.Match(v) {
+			continue
+		}
+		if latest == nil || latest.Before(v) {
+			latest = v
+		}
+	}
+	if latest == nil {
+		return nil, ErrNoRelease
+	}
+	return latest, nil
+}
@@ -0,0 +1,54 @@
+package semver_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+type staticTags []string
+
+func (t staticTags) ListTags(context.Context) ([]string, error) {
+	return t, nil
+}
+
+func TestLatestRelease(t *testing.T) {
+	tags := staticTags{"v1.2.0", "v1.10.0", "v2.0.0-rc.1", "1.9.3", "nightly", "v1.4"}
+
+	tests := []struct {
+		name        string
+		expr        string
+		expected    string
+		expectedErr error
+	}{
+		{"any", "", "1.10.0", nil},
+		{"matching", "minor < 10", "1.9.3", nil},
+		{"no match", "major >= 2", "", semver.ErrNoRelease},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var m semver.Matcher
+			if test.expr != "" {
+				e, err := semver.CompileExpr(test.expr)
+				if err != nil {
+					t.Fatalf("Error compiling expression: %v", err)
+				}
+				m = e
+			}
+
+			v, err := semver.LatestRelease(context.Background(), tags, m)
+			if !errors.Is(err, test.expectedErr) {
+				t.Fatalf("Expected error %v, got %v", test.expectedErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if v.String() != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, v)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package semver
+
+import (
+	"strings"
+)
+
+// RenderOptions configure the output of Render.
+type RenderOptions struct {
+	// LowerPreRelease lower-cases the pre-release identifiers.
+	LowerPreRelease bool
+	// LowerBuild lower-cases the build metadata.
+	LowerBuild bool
+	// OmitEpoch omits a non-zero epoch to get a canonical semantic version.
+	OmitEpoch bool
+}
+
+// Render returns the string representation of the version with the given options applied.
+// This only changes the output, e.g. to store canonical forms for case-insensitive registries.
+// Precedence of the version is still determined by the original identifiers.
+func (v *Version) Render(opts RenderOptions) string {
+	r := *v
+	if opts.LowerPreRelease {
+		r.PreRelease = strings.ToLower(r.PreRelease)
+	}
+	if opts.OmitEpoch {
+		r.Epoch = 0
+	}
+	if opts.LowerBuild {
+		r.Build = strings.ToLower(r.Build)
+	}
+	return r.String()
+}
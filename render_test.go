@@ -0,0 +1,33 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		version  string
+		opts     semver.RenderOptions
+		expected string
+	}{
+		{"1.0.0-RC.1+Build.ABC", semver.RenderOptions{}, "1.0.0-RC.1+Build.ABC"},
+		{"1.0.0-RC.1+Build.ABC", semver.RenderOptions{LowerPreRelease: true}, "1.0.0-rc.1+Build.ABC"},
+		{"1.0.0-RC.1+Build.ABC", semver.RenderOptions{LowerBuild: true}, "1.0.0-RC.1+build.abc"},
+		{"1.0.0-RC.1+Build.ABC", semver.RenderOptions{LowerPreRelease: true, LowerBuild: true}, "1.0.0-rc.1+build.abc"},
+		{"1.0.0", semver.RenderOptions{LowerPreRelease: true, LowerBuild: true}, "1.0.0"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expected, func(t *testing.T) {
+			v := mustParse(t, test.version)
+			if s := v.Render(test.opts); s != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, s)
+			}
+			if v.String() != test.version {
+				t.Errorf("Expected version to be unchanged, got %q", v)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Package rollout decides which installations receive an update in a staged rollout.
+package rollout
+
+import (
+	"hash/fnv"
+
+	"github.com/networkteam/semver"
+)
+
+// Stage is a stage (or ring) of a rollout.
+type Stage struct {
+	Name string
+	// Match selects the installed versions included in the stage, nil includes all versions.
+	Match semver.Matcher
+	// Percentage is the share of cohorts (0 to 100) that receive the update in this stage.
+	Percentage int
+}
+
+// Plan is a staged rollout of an update. Stages are evaluated in order, an installation receives the update in the
+// first stage that includes it.
+type Plan struct {
+	Stages []Stage
+}
+
+// Evaluate decides if an installation at the installed version in the given cohort (e.g. an installation or
+// customer ID) should receive the update. It returns the stage that includes the installation.
+//
+// Cohorts are assigned to a stable percentile by hashing, so increasing the percentage of a stage only adds cohorts.
+// Installations at a version with no lower precedence than the update never receive it.
+func (p Plan) Evaluate(installed, update *semver.Version, cohort string) (Stage, bool) {
+	if installed.AtLeast(update) {
+		return Stage{}, false
+	}
+
+	percentile := cohortPercentile(cohort)
+	for _, s := range p.Stages {
+		if s.Match != nil && !s.Match.Match(installed) {
+			continue
+		}
+		if percentile < s.Percentage {
+			return s, true
+		}
+	}
+	return Stage{}, false
+}
+
+// ShouldReceive determines if an installation at the installed version in the given cohort should receive the update.
+func (p Plan) ShouldReceive(installed, update *semver.Version, cohort string) bool {
+	_, ok := p.Evaluate(installed, update, cohort)
+	return ok
+}
+
+// cohortPercentile returns a stable value from 0 to 99 for the cohort.
+func cohortPercentile(cohort string) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(cohort))
+	return int(h.Sum32() % 100)
+}
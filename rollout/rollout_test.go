@@ -0,0 +1,94 @@
+package rollout_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/rollout"
+)
+
+func TestPlan_Evaluate(t *testing.T) {
+	beta, err := semver.CompileExpr(`prerelease != ""`)
+	if err != nil {
+		t.Fatalf("Error compiling expression: %v", err)
+	}
+	legacy, err := semver.CompilePattern("1.*")
+	if err != nil {
+		t.Fatalf("Error compiling pattern: %v", err)
+	}
+
+	plan := rollout.Plan{
+		Stages: []rollout.Stage{
+			{Name: "beta", Match: beta, Percentage: 100},
+			{Name: "legacy", Match: legacy, Percentage: 0},
+			{Name: "canary", Percentage: 10},
+		},
+	}
+	update := mustParse(t, "2.1.0")
+
+	tests := []struct {
+		installed     string
+		cohort        string
+		expected      bool
+		expectedStage string
+	}{
+		{"2.1.0-rc.1", "customer-1", true, "beta"},
+		{"1.9.0", "customer-1", false, ""},
+		{"2.1.0", "customer-1", false, ""},
+		{"2.0.0", "customer-18", true, "canary"},
+		{"2.0.0", "customer-1", false, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.installed+" "+test.cohort, func(t *testing.T) {
+			stage, ok := plan.Evaluate(mustParse(t, test.installed), update, test.cohort)
+			if ok != test.expected {
+				t.Errorf("Expected %v, got %v", test.expected, ok)
+			}
+			if stage.Name != test.expectedStage {
+				t.Errorf("Expected stage %q, got %q", test.expectedStage, stage.Name)
+			}
+		})
+	}
+}
+
+func TestPlan_ShouldReceive_Percentage(t *testing.T) {
+	installed := mustParse(t, "1.0.0")
+	update := mustParse(t, "1.1.0")
+
+	received := func(percentage int) map[string]bool {
+		plan := rollout.Plan{Stages: []rollout.Stage{{Name: "all", Percentage: percentage}}}
+		result := make(map[string]bool)
+		for i := 0; i < 1000; i++ {
+			cohort := fmt.Sprintf("installation-%d", i)
+			if plan.ShouldReceive(installed, update, cohort) {
+				result[cohort] = true
+			}
+		}
+		return result
+	}
+
+	ten := received(10)
+	fifty := received(50)
+	if len(ten) < 70 || len(ten) > 130 {
+		t.Errorf("Expected about 100 cohorts at 10%%, got %d", len(ten))
+	}
+	for cohort := range ten {
+		if !fifty[cohort] {
+			t.Errorf("Expected cohort %s to still receive the update at 50%%", cohort)
+		}
+	}
+	if len(received(100)) != 1000 {
+		t.Errorf("Expected all cohorts at 100%%")
+	}
+}
+
+func mustParse(t *testing.T, version string) *semver.Version {
+	t.Helper()
+	v, err := semver.ParseVersion(version)
+	if err != nil {
+		t.Fatalf("Error parsing version %q: %v", version, err)
+	}
+	return v
+}
@@ -0,0 +1,136 @@
+package semver
+
+import (
+	"sort"
+)
+
+// SampleVersions returns up to n representative versions for the expression: versions on, just below and just above
+// the boundaries given by the literals of the expression. Matching and non-matching versions are alternated, so both
+// sides of the boundaries are covered if n is small. The result is sorted by precedence.
+//
+// This is meant to exercise edge cases in property-based tests and API mocks.
+func (e *Expr) SampleVersions(n int) []*Version {
+	var lits exprLiterals
+	lits.collect(e.root)
+
+	seen := make(map[string]bool)
+	var candidates []*Version
+	add := func(v Version) {
+		if v.Major < 0 || v.Minor < 0 || v.Patch < 0 {
+			return
+		}
+		s := v.String()
+		if seen[s] {
+			return
+		}
+		if _, err := ParseVersionWithOptions(s, ParseOptions{}); err != nil {
+			return
+		}
+		seen[s] = true
+		candidates = append(candidates, &v)
+	}
+
+	bases := []Version{{}, {Major: 1}}
+	for _, v := range lits.versions {
+		bases = append(bases, *v)
+	}
+
+	for _, b := range bases {
+		add(b)
+		add(Version{Major: b.Major, Minor: b.Minor, Patch: b.Patch + 1})
+		if b.PreRelease == "" {
+			// The lowest pre-release is just below the version
+			add(Version{Major: b.Major, Minor: b.Minor, Patch: b.Patch, PreRelease: "0"})
+			add(previousRelease(b))
+		} else {
+			add(Version{Major: b.Major, Minor: b.Minor, Patch: b.Patch, PreRelease: b.PreRelease + ".0"})
+			add(Version{Major: b.Major, Minor: b.Minor, Patch: b.Patch})
+		}
+
+		for _, c := range lits.ints {
+			for _, delta := range []int{-1, 0, 1} {
+				v := b
+				switch c.field {
+				case "major":
+					v.Major = c.value + delta
+				case "minor":
+					v.Minor = c.value + delta
+				case "patch":
+					v.Patch = c.value + delta
+				}
+				add(v)
+			}
+		}
+
+		for _, c := range lits.strings {
+			v := b
+			if c.field == "prerelease" {
+				v.PreRelease = c.value
+			} else {
+				v.Build = c.value
+			}
+			add(v)
+		}
+	}
+
+	var matching, other []*Version
+	for _, v := range candidates {
+		if e.Match(v) {
+			matching = append(matching, v)
+		} else {
+			other = append(other, v)
+		}
+	}
+
+	var result []*Version
+	for i := 0; len(result) < n && (i < len(matching) || i < len(other)); i++ {
+		if i < len(matching) {
+			result = append(result, matching[i])
+		}
+		if i < len(other) && len(result) < n {
+			result = append(result, other[i])
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Before(result[j])
+	})
+	return result
+}
+
+// previousRelease returns the closest release with a lower precedence and only the lowest non-zero part decremented.
+func previousRelease(v Version) Version {
+	switch {
+	case v.Patch > 0:
+		return Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch - 1}
+	case v.Minor > 0:
+		return Version{Major: v.Major, Minor: v.Minor - 1}
+	default:
+		return Version{Major: v.Major - 1}
+	}
+}
+
+// exprLiterals are the literals of the comparisons in an expression.
+type exprLiterals struct {
+	ints     []intComparison
+	strings  []stringComparison
+	versions []*Version
+}
+
+func (l *exprLiterals) collect(node exprNode) {
+	switch n := node.(type) {
+	case andNode:
+		l.collect(n.left)
+		l.collect(n.right)
+	case orNode:
+		l.collect(n.left)
+		l.collect(n.right)
+	case notNode:
+		l.collect(n.operand)
+	case intComparison:
+		l.ints = append(l.ints, n)
+	case stringComparison:
+		l.strings = append(l.strings, n)
+	case versionComparison:
+		l.versions = append(l.versions, n.value)
+	}
+}
@@ -0,0 +1,65 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestExpr_SampleVersions(t *testing.T) {
+	tests := []struct {
+		expr string
+		n    int
+	}{
+		{`version >= "1.4.0" && version < "2.0.0"`, 10},
+		{`major == 2 && prerelease == ""`, 6},
+		{`build contains "linux" || minor > 3`, 8},
+		{`version == "1.0.0-rc.1"`, 4},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			e, err := semver.CompileExpr(test.expr)
+			if err != nil {
+				t.Fatalf("Error compiling expression: %v", err)
+			}
+
+			samples := e.SampleVersions(test.n)
+			if len(samples) != test.n {
+				t.Fatalf("Expected %d samples, got %d: %v", test.n, len(samples), versionStrings(samples))
+			}
+
+			var matching, other int
+			for i, v := range samples {
+				if e.Match(v) {
+					matching++
+				} else {
+					other++
+				}
+				if i > 0 && v.Before(samples[i-1]) {
+					t.Errorf("Expected samples to be sorted, got %v", versionStrings(samples))
+				}
+			}
+			if matching == 0 || other == 0 {
+				t.Errorf("Expected matching and non-matching samples, got %v", versionStrings(samples))
+			}
+		})
+	}
+}
+
+func TestExpr_SampleVersions_Boundaries(t *testing.T) {
+	e, err := semver.CompileExpr(`version >= "1.4.0"`)
+	if err != nil {
+		t.Fatalf("Error compiling expression: %v", err)
+	}
+
+	samples := make(map[string]bool)
+	for _, v := range e.SampleVersions(100) {
+		samples[v.String()] = true
+	}
+	for _, expected := range []string{"1.4.0", "1.4.0-0", "1.3.0", "1.4.1"} {
+		if !samples[expected] {
+			t.Errorf("Expected sample %s", expected)
+		}
+	}
+}
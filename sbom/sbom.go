@@ -0,0 +1,122 @@
+// Package sbom extracts the versions of the components of a software bill of materials (SBOM).
+//
+// Extract reads CycloneDX and SPDX documents in JSON format. The ecosystem of a component is the type of its package
+// URL (purl), e.g. "npm" or "golang", and selects how the version is parsed with purl.ParseVersion, e.g. Go module
+// versions have a "v" prefix, deb versions are Debian versions and alpm versions are Arch Linux versions. Versions of
+// other ecosystems are parsed as semantic versions, but they often don't follow the spec, which is reported per
+// component.
+package sbom
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/purl"
+)
+
+// Component is a component of an SBOM with its parsed version.
+type Component struct {
+	// Name of the component.
+	Name string
+	// RawVersion is the version as given in the document.
+	RawVersion string
+	// PURL is the package URL of the component, it is empty if the document has none.
+	PURL string
+	// Ecosystem is the type of the package URL, it is empty without a package URL.
+	Ecosystem string
+	// Version is the parsed semantic version, it is nil if the version is missing, invalid or of another dialect.
+	Version *semver.Version
+	// Parsed is the version parsed with the dialect of the ecosystem (e.g. a *purl.DebianVersion), it is the same as
+	// Version for semantic versions.
+	Parsed purl.Version
+	// Err is the error of parsing the version.
+	Err error
+}
+
+// ErrNoVersion is set as the error of components without a version.
+var ErrNoVersion = errors.New("component has no version")
+
+// Extract reads a CycloneDX or SPDX document in JSON format and returns its components in document order, nested
+// CycloneDX components follow their parent. It returns an error if the document is invalid or has an unknown format.
+func Extract(r io.Reader) ([]Component, error) {
+	var doc struct {
+		BOMFormat   string `json:"bomFormat"`
+		SPDXVersion string `json:"spdxVersion"`
+
+		Components []cycloneDXComponent `json:"components"`
+		Packages   []spdxPackage        `json:"packages"`
+	}
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("decoding document: %w", err)
+	}
+
+	var components []Component
+	switch {
+	case doc.BOMFormat == "CycloneDX":
+		var walk func(cs []cycloneDXComponent)
+		walk = func(cs []cycloneDXComponent) {
+			for _, c := range cs {
+				components = append(components, newComponent(c.Name, c.Version, c.PURL))
+				walk(c.Components)
+			}
+		}
+		walk(doc.Components)
+	case doc.SPDXVersion != "":
+		for _, p := range doc.Packages {
+			var purl string
+			for _, ref := range p.ExternalRefs {
+				if ref.ReferenceType == "purl" {
+					purl = ref.ReferenceLocator
+					break
+				}
+			}
+			components = append(components, newComponent(p.Name, p.VersionInfo, purl))
+		}
+	default:
+		return nil, errors.New("unknown document format, expected CycloneDX or SPDX")
+	}
+	return components, nil
+}
+
+type cycloneDXComponent struct {
+	Name       string               `json:"name"`
+	Version    string               `json:"version"`
+	PURL       string               `json:"purl"`
+	Components []cycloneDXComponent `json:"components"`
+}
+
+type spdxPackage struct {
+	Name         string `json:"name"`
+	VersionInfo  string `json:"versionInfo"`
+	ExternalRefs []struct {
+		ReferenceType    string `json:"referenceType"`
+		ReferenceLocator string `json:"referenceLocator"`
+	} `json:"externalRefs"`
+}
+
+func newComponent(name, version, packageURL string) Component {
+	c := Component{Name: name, RawVersion: version, PURL: packageURL}
+	if p, err := purl.Parse(packageURL); err == nil {
+		c.Ecosystem = p.Type
+	}
+	if version == "" {
+		c.Err = ErrNoVersion
+		return c
+	}
+
+	switch c.Ecosystem {
+	case "npm", "golang", "cargo", "deb", "alpm":
+		c.Parsed, c.Err = purl.ParseVersion(c.Ecosystem, version)
+	default:
+		if v, err := semver.ParseVersionWithOptions(version, semver.ParseOptions{}); err != nil {
+			c.Err = err
+		} else {
+			c.Parsed = v
+		}
+	}
+	c.Version, _ = c.Parsed.(*semver.Version)
+	return c
+}
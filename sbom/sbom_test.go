@@ -0,0 +1,83 @@
+package sbom_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/networkteam/semver/purl"
+	"github.com/networkteam/semver/sbom"
+)
+
+func TestExtract_CycloneDX(t *testing.T) {
+	components, err := sbom.Extract(strings.NewReader(`{
+  "bomFormat": "CycloneDX",
+  "specVersion": "1.5",
+  "components": [
+    {"name": "left-pad", "version": "1.3.0", "purl": "pkg:npm/left-pad@1.3.0"},
+    {"name": "github.com/networkteam/semver", "version": "v1.2.0", "purl": "pkg:golang/github.com/networkteam/semver@v1.2.0",
+      "components": [{"name": "serde", "version": "1.0.197", "purl": "pkg:cargo/serde@1.0.197"}]},
+    {"name": "openssl", "version": "3.0.11-1~deb12u2", "purl": "pkg:deb/debian/openssl@3.0.11-1~deb12u2"},
+    {"name": "internal"},
+    {"name": "pacman", "version": "1:6.0.2-1", "purl": "pkg:alpm/arch/pacman@1:6.0.2-1"}
+  ]
+}`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []string{
+		"left-pad npm 1.3.0 <nil>",
+		"github.com/networkteam/semver golang 1.2.0 <nil>",
+		"serde cargo 1.0.197 <nil>",
+		"openssl deb <nil> <nil>",
+		"internal  <nil> component has no version",
+		"pacman alpm <nil> <nil>",
+	}
+	if len(components) != len(expected) {
+		t.Fatalf("Expected %d components, got %d", len(expected), len(components))
+	}
+	for i, c := range components {
+		got := fmt.Sprintf("%s %s %v %v", c.Name, c.Ecosystem, c.Version, c.Err)
+		if got != expected[i] {
+			t.Errorf("Expected %q, got %q", expected[i], got)
+		}
+	}
+	if parsed := components[3].Parsed; parsed == nil || parsed.String() != "3.0.11-1~deb12u2" {
+		t.Errorf("Expected Debian version, got %v", parsed)
+	}
+	if parsed, ok := components[5].Parsed.(*purl.AlpmVersion); !ok || parsed.Epoch != 1 || parsed.Release != "1" {
+		t.Errorf("Expected Arch Linux version, got %v", components[5].Parsed)
+	}
+}
+
+func TestExtract_SPDX(t *testing.T) {
+	components, err := sbom.Extract(strings.NewReader(`{
+  "spdxVersion": "SPDX-2.3",
+  "packages": [
+    {"name": "left-pad", "versionInfo": "v1.3.0",
+      "externalRefs": [{"referenceCategory": "PACKAGE-MANAGER", "referenceType": "purl", "referenceLocator": "pkg:npm/left-pad@1.3.0"}]},
+    {"name": "golang.org/x/text", "versionInfo": "0.14.0",
+      "externalRefs": [{"referenceType": "purl", "referenceLocator": "pkg:golang/golang.org/x/text@0.14.0"}]}
+  ]
+}`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(components) != 2 {
+		t.Fatalf("Expected 2 components, got %d", len(components))
+	}
+	if c := components[0]; c.Version.String() != "1.3.0" || c.PURL != "pkg:npm/left-pad@1.3.0" {
+		t.Errorf("Unexpected component %+v", c)
+	}
+	if c := components[1]; c.Err == nil || c.Err.Error() != `go module version "0.14.0" has no v prefix` {
+		t.Errorf("Unexpected error %v", c.Err)
+	}
+}
+
+func TestExtract_UnknownFormat(t *testing.T) {
+	if _, err := sbom.Extract(strings.NewReader(`{"packages": []}`)); err == nil {
+		t.Errorf("Expected error for unknown format")
+	}
+}
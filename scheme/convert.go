@@ -0,0 +1,239 @@
+package scheme
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/purl"
+)
+
+// LossKind identifies the information lost by a conversion.
+type LossKind string
+
+// Kinds of losses.
+const (
+	// LossEpoch is an epoch the target scheme does not support.
+	LossEpoch LossKind = "dropped_epoch"
+	// LossRevision is a Debian revision or Arch Linux release.
+	LossRevision LossKind = "dropped_revision"
+	// LossBuild is build metadata the target scheme does not support.
+	LossBuild LossKind = "dropped_build"
+	// LossComponents are numeric components beyond major, minor and patch.
+	LossComponents LossKind = "dropped_components"
+	// LossQualifier is a qualifier (e.g. a pre-release) that was remapped to a construct with a similar but not
+	// necessarily the same ordering.
+	LossQualifier LossKind = "remapped_qualifier"
+)
+
+// Loss is information lost by a conversion, comparisons of converted versions are approximate.
+type Loss struct {
+	Kind LossKind
+	// Detail describes the loss, e.g. `dropped epoch 1`.
+	Detail string
+}
+
+// String returns the detail of the loss.
+func (l Loss) String() string {
+	return l.Detail
+}
+
+// Conversion is the result of Convert.
+type Conversion struct {
+	Version Version
+	Losses  []Loss
+}
+
+// Exact determines if the conversion lost no information.
+func (c *Conversion) Exact() bool {
+	return len(c.Losses) == 0
+}
+
+// semverSchemes are the schemes of semantic versions.
+var semverSchemes = map[string]bool{"semver": true, "npm": true, "golang": true, "cargo": true}
+
+// Convert converts a version of a built-in scheme to the built-in scheme with the name on a best-effort basis.
+//
+// The conversion goes through a semantic version: numeric components are padded to major, minor and patch, qualifiers
+// that sort before the release (e.g. "~rc1" of Debian or "rc1" of Arch Linux) become pre-releases and other suffixes
+// become build metadata. Everything the target scheme cannot represent is dropped or remapped and reported in the
+// losses of the conversion.
+func Convert(v Version, to string) (*Conversion, error) {
+	target, ok := Lookup(to)
+	if !ok {
+		return nil, fmt.Errorf("unknown scheme %q", to)
+	}
+	sv, losses, err := toSemver(v)
+	if err != nil {
+		return nil, err
+	}
+	s, targetLosses, ok := fromSemver(strings.ToLower(to), sv)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert to scheme %q", to)
+	}
+	converted, err := target.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("converting %q to scheme %q: %w", v, to, err)
+	}
+	return &Conversion{Version: converted, Losses: append(losses, targetLosses...)}, nil
+}
+
+// toSemver converts a version of a built-in scheme to a semantic version.
+func toSemver(v Version) (*semver.Version, []Loss, error) {
+	switch v := v.(type) {
+	case *semver.Version:
+		return v, nil, nil
+	case *purl.DebianVersion:
+		sv, losses := parseLoose(v.Upstream)
+		sv.Epoch = v.Epoch
+		if v.Revision != "" {
+			losses = append(losses, Loss{Kind: LossRevision, Detail: fmt.Sprintf("dropped revision %q", v.Revision)})
+		}
+		return sv, losses, nil
+	case *purl.AlpmVersion:
+		sv, losses := parseLoose(v.Version)
+		sv.Epoch = v.Epoch
+		if v.Release != "" {
+			losses = append(losses, Loss{Kind: LossRevision, Detail: fmt.Sprintf("dropped release %q", v.Release)})
+		}
+		return sv, losses, nil
+	case nixVersion:
+		sv, losses := parseLoose(string(v))
+		return sv, losses, nil
+	}
+	return nil, nil, fmt.Errorf("cannot convert version %q of type %T", v, v)
+}
+
+// parseLoose parses the leading numeric components of s and remaps the rest to a pre-release if it starts with a
+// tilde or a letter, otherwise to build metadata.
+func parseLoose(s string) (*semver.Version, []Loss) {
+	var (
+		numbers []int
+		losses  []Loss
+		rest    = s
+	)
+	for {
+		digits := len(rest) - len(strings.TrimLeft(rest, "0123456789"))
+		if digits == 0 {
+			break
+		}
+		n, err := strconv.Atoi(rest[:digits])
+		if err != nil {
+			break
+		}
+		numbers = append(numbers, n)
+		rest = rest[digits:]
+		if len(rest) < 2 || rest[0] != '.' || !isDigit(rest[1]) {
+			break
+		}
+		rest = rest[1:]
+	}
+
+	sv := &semver.Version{}
+	for i, n := range numbers {
+		switch i {
+		case 0:
+			sv.Major = n
+		case 1:
+			sv.Minor = n
+		case 2:
+			sv.Patch = n
+		}
+	}
+	if len(numbers) > 3 {
+		losses = append(losses, Loss{Kind: LossComponents, Detail: fmt.Sprintf("dropped components %v", numbers[3:])})
+	}
+
+	if rest == "" {
+		return sv, losses
+	}
+	identifiers := sanitizeIdentifiers(rest)
+	if identifiers == "" {
+		losses = append(losses, Loss{Kind: LossQualifier, Detail: fmt.Sprintf("dropped qualifier %q", rest)})
+	} else if rest[0] == '~' || isLetter(rest[0]) {
+		sv.PreRelease = identifiers
+		losses = append(losses, Loss{Kind: LossQualifier, Detail: fmt.Sprintf("remapped qualifier %q to pre-release %q", rest, identifiers)})
+	} else {
+		sv.Build = identifiers
+		losses = append(losses, Loss{Kind: LossQualifier, Detail: fmt.Sprintf("remapped qualifier %q to build %q", rest, identifiers)})
+	}
+	return sv, losses
+}
+
+// sanitizeIdentifiers returns the alphanumeric parts of s as dot-separated SemVer identifiers.
+func sanitizeIdentifiers(s string) string {
+	parts := strings.FieldsFunc(s, func(r rune) bool {
+		return r > 127 || !isDigit(byte(r)) && !isLetter(byte(r))
+	})
+	for i, part := range parts {
+		if strings.Trim(part, "0123456789") == "" {
+			if trimmed := strings.TrimLeft(part, "0"); trimmed != "" {
+				parts[i] = trimmed
+			} else {
+				parts[i] = "0"
+			}
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+// fromSemver formats a semantic version in the syntax of a built-in scheme.
+func fromSemver(to string, v *semver.Version) (string, []Loss, bool) {
+	var losses []Loss
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+
+	if semverSchemes[to] {
+		if v.Epoch != 0 {
+			losses = append(losses, Loss{Kind: LossEpoch, Detail: fmt.Sprintf("dropped epoch %d", v.Epoch)})
+		}
+		if v.PreRelease != "" {
+			s += "-" + v.PreRelease
+		}
+		if v.Build != "" {
+			s += "+" + v.Build
+		}
+		if to == "golang" {
+			s = "v" + s
+		}
+		return s, losses, true
+	}
+
+	var qualifier string
+	switch to {
+	case "deb":
+		qualifier = "~" + strings.ReplaceAll(v.PreRelease, "-", ".")
+	case "alpm":
+		qualifier = strings.ReplaceAll(v.PreRelease, "-", ".")
+		if v.PreRelease != "" && !isLetter(qualifier[0]) {
+			qualifier = "pre" + qualifier
+		}
+	case "nix":
+		qualifier = "pre-" + v.PreRelease
+	default:
+		return "", nil, false
+	}
+	if v.PreRelease != "" {
+		s += qualifier
+		losses = append(losses, Loss{Kind: LossQualifier, Detail: fmt.Sprintf("remapped pre-release %q to %q", v.PreRelease, qualifier)})
+	}
+	if v.Epoch != 0 {
+		if to == "nix" {
+			losses = append(losses, Loss{Kind: LossEpoch, Detail: fmt.Sprintf("dropped epoch %d", v.Epoch)})
+		} else {
+			s = strconv.Itoa(v.Epoch) + ":" + s
+		}
+	}
+	if v.Build != "" {
+		losses = append(losses, Loss{Kind: LossBuild, Detail: fmt.Sprintf("dropped build %q", v.Build)})
+	}
+	return s, losses, true
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
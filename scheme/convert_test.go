@@ -0,0 +1,58 @@
+package scheme_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/networkteam/semver/scheme"
+)
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		from, to       string
+		version        string
+		expected       string
+		expectedLosses string
+		expectedErr    string
+	}{
+		{"npm", "cargo", "1.2.3-rc.1+build.5", "1.2.3-rc.1+build.5", "[]", ""},
+		{"cargo", "golang", "1.2.3", "v1.2.3", "[]", ""},
+		{"deb", "npm", "1:1.0~rc1-2", "1.0.0-rc1", `[remapped qualifier "~rc1" to pre-release "rc1" dropped revision "2" dropped epoch 1]`, ""},
+		{"deb", "semver", "2.4.1+dfsg", "2.4.1+dfsg", `[remapped qualifier "+dfsg" to build "dfsg"]`, ""},
+		{"deb", "alpm", "2:1.2.3.4-1", "2:1.2.3", "[dropped components [4] dropped revision \"1\"]", ""},
+		{"alpm", "deb", "1:6.0.2rc2-9", "1:6.0.2~rc2", `[remapped qualifier "rc2" to pre-release "rc2" dropped release "9" remapped pre-release "rc2" to "~rc2"]`, ""},
+		{"semver", "alpm", "1.0.0-1", "1.0.0pre1", `[remapped pre-release "1" to "pre1"]`, ""},
+		{"nix", "npm", "1.2pre3", "1.2.0-pre3", `[remapped qualifier "pre3" to pre-release "pre3"]`, ""},
+		{"npm", "nix", "1.2.3-rc.1+build", "1.2.3.pre.rc.1", `[remapped pre-release "rc.1" to "pre-rc.1" dropped build "build"]`, ""},
+		{"npm", "maven", "1.2.3", "", "", `unknown scheme "maven"`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.from+" "+test.version+" to "+test.to, func(t *testing.T) {
+			v, err := scheme.Parse(test.from, test.version)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			c, err := scheme.Convert(v, test.to)
+			if err != nil {
+				if err.Error() != test.expectedErr {
+					t.Errorf("Expected error %q, got %q", test.expectedErr, err)
+				}
+				return
+			}
+			if test.expectedErr != "" {
+				t.Fatalf("Expected error %q, got nil", test.expectedErr)
+			}
+			target, _ := scheme.Lookup(test.to)
+			if canonical := target.Canonical(c.Version); canonical != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, canonical)
+			}
+			if losses := fmt.Sprint(c.Losses); losses != test.expectedLosses {
+				t.Errorf("Expected losses %s, got %s", test.expectedLosses, losses)
+			}
+			if c.Exact() != (test.expectedLosses == "[]") {
+				t.Errorf("Expected Exact to be %v", test.expectedLosses == "[]")
+			}
+		})
+	}
+}
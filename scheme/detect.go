@@ -0,0 +1,90 @@
+package scheme
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/purl"
+)
+
+// SchemeGuess is a built-in scheme a version likely belongs to.
+type SchemeGuess struct {
+	Scheme string
+	// Confidence is the likelihood of the guess from 0 (exclusive) to 1.
+	Confidence float64
+}
+
+var (
+	debianRevisionPattern = regexp.MustCompile(`(deb|ubuntu|build)\d`)
+	nixPattern            = regexp.MustCompile(`\dpre\d|^unstable-|-unstable-\d{4}-\d{2}-\d{2}$`)
+)
+
+// DetectScheme ranks the built-in schemes by the likelihood that s is a version of the scheme, e.g. to ingest data
+// with a missing or wrong ecosystem. Guesses are sorted by descending confidence and schemes that cannot parse s are
+// omitted. The confidences are heuristic and only meaningful relative to each other.
+func DetectScheme(s string) []SchemeGuess {
+	scores := map[string]float64{}
+
+	if _, err := semver.ParseVersionWithOptions(s, semver.ParseOptions{}); err == nil {
+		scores["semver"] = 0.9
+		scores["cargo"] = 0.8
+		scores["npm"] = 0.8
+	}
+	if strings.HasPrefix(s, "v") {
+		if _, err := semver.ParseVersionWithOptions(s[1:], semver.ParseOptions{}); err == nil {
+			scores["golang"] = 0.9
+			scores["npm"] = 0.6
+		}
+	}
+	if strings.HasPrefix(s, "=") {
+		if _, err := semver.ParseVersionWithOptions(strings.TrimLeft(s, "v="), semver.ParseOptions{}); err == nil {
+			scores["npm"] = 0.6
+		}
+	}
+
+	if v, err := purl.ParseDebianVersion(s); err == nil {
+		score := 0.3
+		if strings.Contains(s, "~") {
+			score = 0.8
+		}
+		if debianRevisionPattern.MatchString(v.Revision) {
+			score = 0.95
+		}
+		if v.Epoch != 0 {
+			score += 0.2
+		}
+		scores["deb"] = score
+	}
+	if v, err := purl.ParseAlpmVersion(s); err == nil && s[0] >= '0' && s[0] <= '9' {
+		score := 0.2
+		if v.Release != "" && strings.Trim(v.Release, "0123456789") == "" {
+			score = 0.5
+		}
+		if v.Epoch != 0 {
+			score += 0.2
+		}
+		scores["alpm"] = score
+	}
+
+	if s != "" {
+		scores["nix"] = 0.1
+		if nixPattern.MatchString(s) {
+			scores["nix"] = 0.7
+		}
+	}
+
+	guesses := make([]SchemeGuess, 0, len(scores))
+	for name, score := range scores {
+		guesses = append(guesses, SchemeGuess{Scheme: name, Confidence: math.Min(score, 1)})
+	}
+	sort.Slice(guesses, func(i, j int) bool {
+		if guesses[i].Confidence != guesses[j].Confidence {
+			return guesses[i].Confidence > guesses[j].Confidence
+		}
+		return guesses[i].Scheme < guesses[j].Scheme
+	})
+	return guesses
+}
@@ -0,0 +1,49 @@
+package scheme_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/scheme"
+)
+
+func TestDetectScheme(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected string
+	}{
+		{"1.2.3", "[semver cargo npm deb alpm nix]"},
+		{"v0.14.0", "[golang npm nix]"},
+		{"=1.2.3", "[npm nix]"},
+		{"1:3.0.11-1~deb12u2", "[deb alpm nix]"},
+		{"2.4.1-3ubuntu0.1", "[deb semver cargo npm alpm nix]"},
+		{"6.0.2-9", "[semver cargo npm alpm deb nix]"},
+		{"1.0~rc1", "[deb alpm nix]"},
+		{"1.2pre3", "[nix deb alpm]"},
+		{"unstable-2023-01-02", "[nix]"},
+		{"", "[]"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			guesses := scheme.DetectScheme(test.version)
+			var names []string
+			for _, g := range guesses {
+				names = append(names, g.Scheme)
+			}
+			if got := fmt.Sprint(names); got != test.expected {
+				t.Errorf("Expected %s, got %s (%v)", test.expected, got, guesses)
+			}
+		})
+	}
+}
+
+func TestDetectScheme_IgnoresDefaultParseOptions(t *testing.T) {
+	semver.SetDefaultParseOptions(semver.ParseOptions{AllowVPrefix: true})
+	t.Cleanup(func() { semver.SetDefaultParseOptions(semver.ParseOptions{}) })
+
+	if guesses := scheme.DetectScheme("v1.2.3"); len(guesses) == 0 || guesses[0].Scheme != "golang" {
+		t.Errorf("Expected golang as first guess, got %v", guesses)
+	}
+}
@@ -0,0 +1,167 @@
+// Package scheme provides a registry of versioning schemes by ecosystem name, so versions of different dialects
+// (semantic versions, Debian, Arch Linux, Nix, ...) can be parsed, compared and canonicalized in a uniform way.
+//
+// The built-in schemes are "semver", "npm", "golang", "cargo", "deb", "alpm" and "nix". Custom schemes can be added
+// with Register. Convert converts versions between the built-in schemes and reports the information lost.
+package scheme
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/nix"
+	"github.com/networkteam/semver/purl"
+)
+
+// Version is a version parsed by a scheme.
+type Version interface {
+	String() string
+}
+
+// Scheme parses and orders the versions of an ecosystem.
+type Scheme interface {
+	// Parse parses a version of the scheme.
+	Parse(s string) (Version, error)
+	// Compare compares two versions parsed by the scheme and returns -1, 0 or 1.
+	// It panics if a version was not parsed by the scheme.
+	Compare(a, b Version) int
+	// Canonical returns the canonical string of a version parsed by the scheme, versions with the same canonical string
+	// compare as equal.
+	Canonical(v Version) string
+}
+
+var (
+	schemesMu sync.RWMutex
+	schemes   = map[string]Scheme{}
+)
+
+// Register makes a scheme available by the name of its ecosystem. Names are case-insensitive.
+// It panics if the scheme is nil or a scheme with the name is already registered.
+func Register(name string, s Scheme) {
+	schemesMu.Lock()
+	defer schemesMu.Unlock()
+	if s == nil {
+		panic("scheme: Register scheme is nil")
+	}
+	name = strings.ToLower(name)
+	if _, dup := schemes[name]; dup {
+		panic("scheme: Register called twice for scheme " + name)
+	}
+	schemes[name] = s
+}
+
+// Lookup returns the scheme registered with the name.
+func Lookup(name string) (Scheme, bool) {
+	schemesMu.RLock()
+	defer schemesMu.RUnlock()
+	s, ok := schemes[strings.ToLower(name)]
+	return s, ok
+}
+
+// Names returns the sorted names of the registered schemes.
+func Names() []string {
+	schemesMu.RLock()
+	defer schemesMu.RUnlock()
+	names := make([]string, 0, len(schemes))
+	for name := range schemes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Parse parses a version with the scheme registered with the name.
+func Parse(name, s string) (Version, error) {
+	sc, ok := Lookup(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown scheme %q", name)
+	}
+	return sc.Parse(s)
+}
+
+// funcScheme implements a Scheme with functions for a version type.
+type funcScheme[V Version] struct {
+	parse     func(s string) (V, error)
+	compare   func(a, b V) int
+	canonical func(v V) string
+}
+
+func (s funcScheme[V]) Parse(str string) (Version, error) {
+	v, err := s.parse(str)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (s funcScheme[V]) Compare(a, b Version) int {
+	return s.compare(a.(V), b.(V))
+}
+
+func (s funcScheme[V]) Canonical(v Version) string {
+	if s.canonical == nil {
+		return v.String()
+	}
+	return s.canonical(v.(V))
+}
+
+// nixVersion is a version of the nix scheme, any string is a valid version.
+type nixVersion string
+
+func (v nixVersion) String() string {
+	return string(v)
+}
+
+// semverScheme returns a scheme of semantic versions parsed with the dialect of the purl type.
+func semverScheme(typ string) Scheme {
+	return funcScheme[*semver.Version]{
+		parse: func(s string) (*semver.Version, error) {
+			v, err := purl.ParseVersion(typ, s)
+			if err != nil {
+				return nil, err
+			}
+			return v.(*semver.Version), nil
+		},
+		compare: (*semver.Version).Compare,
+		canonical: func(v *semver.Version) string {
+			if typ == "golang" {
+				return "v" + v.String()
+			}
+			return v.String()
+		},
+	}
+}
+
+func init() {
+	Register("semver", funcScheme[*semver.Version]{
+		parse: func(s string) (*semver.Version, error) {
+			return semver.ParseVersionWithOptions(s, semver.ParseOptions{})
+		},
+		compare: (*semver.Version).Compare,
+	})
+	for _, typ := range []string{"npm", "golang", "cargo"} {
+		Register(typ, semverScheme(typ))
+	}
+	Register("deb", funcScheme[*purl.DebianVersion]{
+		parse:   purl.ParseDebianVersion,
+		compare: (*purl.DebianVersion).Compare,
+	})
+	Register("alpm", funcScheme[*purl.AlpmVersion]{
+		parse:   purl.ParseAlpmVersion,
+		compare: (*purl.AlpmVersion).Compare,
+	})
+	Register("nix", funcScheme[nixVersion]{
+		parse: func(s string) (nixVersion, error) {
+			return nixVersion(s), nil
+		},
+		compare: func(a, b nixVersion) int {
+			return nix.Compare(string(a), string(b))
+		},
+		canonical: func(v nixVersion) string {
+			return strings.Join(nix.Components(string(v)), ".")
+		},
+	})
+}
@@ -0,0 +1,100 @@
+package scheme_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/networkteam/semver/scheme"
+)
+
+func TestSchemes(t *testing.T) {
+	tests := []struct {
+		scheme    string
+		a, b      string
+		expected  int
+		canonical string
+	}{
+		{"semver", "1.0.0-rc.1", "1.0.0", -1, "1.0.0-rc.1"},
+		{"npm", "v1.2.3", "=1.2.3", 0, "1.2.3"},
+		{"golang", "v0.14.0", "v0.9.0", 1, "v0.14.0"},
+		{"Cargo", "1.0.197", "1.0.200", -1, "1.0.197"},
+		{"deb", "1:3.0.11-1~deb12u2", "3.1.0-1", 1, "1:3.0.11-1~deb12u2"},
+		{"alpm", "1.0rc", "1.0", -1, "1.0rc"},
+		{"nix", "1.2pre3", "1.2.pre-3", 0, "1.2.pre.3"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scheme, func(t *testing.T) {
+			sc, ok := scheme.Lookup(test.scheme)
+			if !ok {
+				t.Fatalf("Expected scheme %q to be registered", test.scheme)
+			}
+			a, err := sc.Parse(test.a)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			b, err := sc.Parse(test.b)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result := sc.Compare(a, b); result != test.expected {
+				t.Errorf("Expected %d, got %d", test.expected, result)
+			}
+			if canonical := sc.Canonical(a); canonical != test.canonical {
+				t.Errorf("Expected canonical %q, got %q", test.canonical, canonical)
+			}
+		})
+	}
+}
+
+// upperScheme is a custom scheme that orders versions case-insensitively.
+type upperScheme struct{}
+
+type upperVersion string
+
+func (v upperVersion) String() string { return string(v) }
+
+func (upperScheme) Parse(s string) (scheme.Version, error) {
+	if s == "" {
+		return nil, fmt.Errorf("empty version")
+	}
+	return upperVersion(s), nil
+}
+
+func (upperScheme) Compare(a, b scheme.Version) int {
+	return strings.Compare(strings.ToUpper(a.String()), strings.ToUpper(b.String()))
+}
+
+func (upperScheme) Canonical(v scheme.Version) string {
+	return strings.ToUpper(v.String())
+}
+
+func TestRegister(t *testing.T) {
+	scheme.Register("upper", upperScheme{})
+
+	v, err := scheme.Parse("UPPER", "r1a")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	sc, _ := scheme.Lookup("upper")
+	if canonical := sc.Canonical(v); canonical != "R1A" {
+		t.Errorf("Expected %q, got %q", "R1A", canonical)
+	}
+	if names := fmt.Sprint(scheme.Names()); names != "[alpm cargo deb golang nix npm semver upper]" {
+		t.Errorf("Unexpected names %s", names)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected panic for duplicate registration")
+		}
+	}()
+	scheme.Register("Upper", upperScheme{})
+}
+
+func TestParse_UnknownScheme(t *testing.T) {
+	if _, err := scheme.Parse("maven", "1.0"); err == nil || err.Error() != `unknown scheme "maven"` {
+		t.Errorf("Expected unknown scheme error, got %v", err)
+	}
+}
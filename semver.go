@@ -8,6 +8,9 @@ import (
 
 // Version represents a parsed semantic version.
 type Version struct {
+	// Epoch is an optional extension for distribution-style versions (e.g. "1:2.3.4"), see ParseOptions.
+	// It dominates the comparison of versions and is zero for plain semantic versions.
+	Epoch      int
 	Major      int
 	Minor      int
 	Patch      int
@@ -16,14 +19,27 @@ type Version struct {
 }
 
 // ParseVersion parses a semantic version string and returns a Version struct or an error if the version is invalid.
+// It uses the default parse options, see SetDefaultParseOptions.
 func ParseVersion(version string) (*Version, error) {
 	p := NewParser(version)
 	return p.ParseVersion()
 }
 
+// ParseVersionWithOptions parses a version like ParseVersion with extensions of the syntax enabled by the options.
+func ParseVersionWithOptions(version string, opts ParseOptions) (*Version, error) {
+	p := &Parser{input: version, opts: opts}
+	return p.ParseVersion()
+}
+
 // String returns the string representation of the Version.
+// Parsing the result with ParseVersion (with the Epoch option for a non-zero epoch) returns a version with the same
+// fields, see Normalize.
+// A non-zero epoch is rendered as a prefix, use Render with OmitEpoch to get a canonical semantic version.
 func (v *Version) String() string {
 	version := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Epoch != 0 {
+		version = strconv.Itoa(v.Epoch) + ":" + version
+	}
 	if v.PreRelease != "" {
 		version += "-" + v.PreRelease
 	}
@@ -33,6 +49,27 @@ func (v *Version) String() string {
 	return version
 }
 
+// precedenceString returns the version without build metadata. Versions have the same precedence string if and only
+// if they have equal precedence, so it is a stable key for hashing.
+func (v *Version) precedenceString() string {
+	withoutBuild := *v
+	withoutBuild.Build = ""
+	return withoutBuild.String()
+}
+
+// MetricLabel returns a rendering of the version for use as a metric label value.
+// The build metadata is omitted, since it usually changes with every build and would create a new time series for each.
+func (v *Version) MetricLabel() string {
+	label := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Epoch != 0 {
+		label = strconv.Itoa(v.Epoch) + ":" + label
+	}
+	if v.PreRelease != "" {
+		label += "-" + v.PreRelease
+	}
+	return label
+}
+
 // compareIdentifiers compares two identifiers according to SemVer rules.
 func compareIdentifiers(a, b string) int {
 	aNum, aIsNumeric := checkNumeric(a)
@@ -120,7 +157,8 @@ func comparePreRelease(a, b string) int {
 
 // Equals determines if this version is equal to the provided version (ignoring the build metadata).
 func (v *Version) Equals(other *Version) bool {
-	return v.Major == other.Major &&
+	return v.Epoch == other.Epoch &&
+		v.Major == other.Major &&
 		v.Minor == other.Minor &&
 		v.Patch == other.Patch &&
 		v.PreRelease == other.PreRelease
@@ -128,14 +166,72 @@ func (v *Version) Equals(other *Version) bool {
 
 // Before determines if this version is before the provided version (ignoring the build metadata).
 func (v *Version) Before(other *Version) bool {
-	if v.Major != other.Major {
-		return v.Major < other.Major
+	return compareVersions(v, other) == -1
+}
+
+// After determines if this version is after the provided version (ignoring the build metadata).
+func (v *Version) After(other *Version) bool {
+	return compareVersions(v, other) == 1
+}
+
+// AtLeast determines if this version is equal to or after the provided version (ignoring the build metadata).
+func (v *Version) AtLeast(other *Version) bool {
+	return compareVersions(v, other) >= 0
+}
+
+// AtMost determines if this version is equal to or before the provided version (ignoring the build metadata).
+func (v *Version) AtMost(other *Version) bool {
+	return compareVersions(v, other) <= 0
+}
+
+// Compare compares this version to the provided version by precedence (ignoring the build metadata) and returns -1, 0
+// or 1.
+func (v *Version) Compare(other *Version) int {
+	return compareVersions(v, other)
+}
+
+// CompareTotal compares this version to the provided version like Compare, but compares the build metadata of versions
+// with equal precedence, e.g. for a stable, deterministic order of tags. This is an extension: SemVer ignores the
+// build metadata for precedence.
+// A version without build metadata is before one with build metadata, which are compared by identifiers like
+// pre-releases and finally lexically, so only versions with equal strings compare as 0.
+func (v *Version) CompareTotal(other *Version) int {
+	if result := compareVersions(v, other); result != 0 {
+		return result
+	}
+	switch {
+	case v.Build == other.Build:
+		return 0
+	case v.Build == "":
+		return -1
+	case other.Build == "":
+		return 1
+	}
+	if result := comparePreRelease(v.Build, other.Build); result != 0 {
+		return result
+	}
+	return strings.Compare(v.Build, other.Build)
+}
+
+// Compare compares two versions by precedence (ignoring the build metadata) and returns -1, 0 or 1, e.g. for
+// slices.SortFunc or slices.BinarySearchFunc.
+func Compare(a, b *Version) int {
+	return compareVersions(a, b)
+}
+
+// compareVersions compares two versions according to SemVer precedence (ignoring the build metadata).
+func compareVersions(a, b *Version) int {
+	if a.Epoch != b.Epoch {
+		return compareInts(a.Epoch, b.Epoch)
+	}
+	if a.Major != b.Major {
+		return compareInts(a.Major, b.Major)
 	}
-	if v.Minor != other.Minor {
-		return v.Minor < other.Minor
+	if a.Minor != b.Minor {
+		return compareInts(a.Minor, b.Minor)
 	}
-	if v.Patch != other.Patch {
-		return v.Patch < other.Patch
+	if a.Patch != b.Patch {
+		return compareInts(a.Patch, b.Patch)
 	}
-	return comparePreRelease(v.PreRelease, other.PreRelease) == -1
+	return comparePreRelease(a.PreRelease, b.PreRelease)
 }
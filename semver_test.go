@@ -1,6 +1,8 @@
 package semver_test
 
 import (
+	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/networkteam/semver"
@@ -108,6 +110,171 @@ func TestBefore(t *testing.T) {
 	}
 }
 
+func TestAfter(t *testing.T) {
+	tests := []struct {
+		v1       string
+		v2       string
+		expected bool
+	}{
+		{"1.0.1", "1.0.0", true},
+		{"1.0.0", "1.0.0-rc.1", true},
+		{"1.0.0-beta.11", "1.0.0-beta.2", true},
+		{"1.0.0", "1.0.0", false},
+		{"1.0.0+build2", "1.0.0+build1", false}, // Build metadata does not affect precedence
+		{"1.0.0", "1.0.1", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.v1+" > "+test.v2, func(t *testing.T) {
+			v1, err1 := semver.ParseVersion(test.v1)
+			if err1 != nil {
+				t.Errorf("Error parsing version %q: %v", test.v1, err1)
+				return
+			}
+			v2, err2 := semver.ParseVersion(test.v2)
+			if err2 != nil {
+				t.Errorf("Error parsing version %q: %v", test.v2, err2)
+				return
+			}
+
+			result := v1.After(v2)
+			if result != test.expected {
+				t.Errorf("Expected %q.After(%q) to be %v, got %v", test.v1, test.v2, test.expected, result)
+			}
+		})
+	}
+}
+
+func TestAtLeastAtMost(t *testing.T) {
+	tests := []struct {
+		v1      string
+		v2      string
+		atLeast bool
+		atMost  bool
+	}{
+		{"1.0.1", "1.0.0", true, false},
+		{"1.0.0", "1.0.0", true, true},
+		{"1.0.0+build2", "1.0.0+build1", true, true}, // Build metadata does not affect precedence
+		{"1.0.0-rc.1", "1.0.0", false, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.v1+" <=> "+test.v2, func(t *testing.T) {
+			v1, err1 := semver.ParseVersion(test.v1)
+			if err1 != nil {
+				t.Errorf("Error parsing version %q: %v", test.v1, err1)
+				return
+			}
+			v2, err2 := semver.ParseVersion(test.v2)
+			if err2 != nil {
+				t.Errorf("Error parsing version %q: %v", test.v2, err2)
+				return
+			}
+
+			if result := v1.AtLeast(v2); result != test.atLeast {
+				t.Errorf("Expected %q.AtLeast(%q) to be %v, got %v", test.v1, test.v2, test.atLeast, result)
+			}
+			if result := v1.AtMost(v2); result != test.atMost {
+				t.Errorf("Expected %q.AtMost(%q) to be %v, got %v", test.v1, test.v2, test.atMost, result)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		v1       string
+		v2       string
+		expected int
+	}{
+		{"1.0.0", "1.0.1", -1},
+		{"1.0.0-alpha", "1.0.0", -1},
+		{"1.0.0-beta.11", "1.0.0-beta.2", 1},
+		{"2.0.0", "1.9.9", 1},
+		{"1.0.0+build1", "1.0.0+build2", 0}, // Build metadata does not affect precedence
+		{"1.0.0-rc.1", "1.0.0-rc.1", 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.v1+" <=> "+test.v2, func(t *testing.T) {
+			v1, err1 := semver.ParseVersion(test.v1)
+			if err1 != nil {
+				t.Errorf("Error parsing version %q: %v", test.v1, err1)
+				return
+			}
+			v2, err2 := semver.ParseVersion(test.v2)
+			if err2 != nil {
+				t.Errorf("Error parsing version %q: %v", test.v2, err2)
+				return
+			}
+
+			result := v1.Compare(v2)
+			if result != test.expected {
+				t.Errorf("Expected %q.Compare(%q) to be %d, got %d", test.v1, test.v2, test.expected, result)
+			}
+		})
+	}
+}
+
+func TestCompareTotal(t *testing.T) {
+	tests := []struct {
+		v1       string
+		v2       string
+		expected int
+	}{
+		{"1.0.0-rc.1+build.9", "1.0.0+build.1", -1},
+		{"1.0.0", "1.0.0+build.1", -1},
+		{"1.0.0+build.2", "1.0.0+build.10", -1},
+		{"1.0.0+build", "1.0.0+build.1", -1},
+		{"1.0.0+exp.sha.5114f85", "1.0.0+exp.sha.5114f86", -1},
+		{"1.0.0+build.1", "1.0.0+build.01", -1},
+		{"1.0.0+build.1", "1.0.0+build.1", 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.v1+" <=> "+test.v2, func(t *testing.T) {
+			v1, err1 := semver.ParseVersion(test.v1)
+			if err1 != nil {
+				t.Errorf("Error parsing version %q: %v", test.v1, err1)
+				return
+			}
+			v2, err2 := semver.ParseVersion(test.v2)
+			if err2 != nil {
+				t.Errorf("Error parsing version %q: %v", test.v2, err2)
+				return
+			}
+
+			if result := v1.CompareTotal(v2); result != test.expected {
+				t.Errorf("Expected %q.CompareTotal(%q) to be %d, got %d", test.v1, test.v2, test.expected, result)
+			}
+			if result := v2.CompareTotal(v1); result != -test.expected {
+				t.Errorf("Expected %q.CompareTotal(%q) to be %d, got %d", test.v2, test.v1, -test.expected, result)
+			}
+		})
+	}
+}
+
+func TestCompare_SortFunc(t *testing.T) {
+	var versions []*semver.Version
+	for _, s := range []string{"1.10.0", "1.0.0", "1.2.0-rc.1", "1.2.0"} {
+		v, err := semver.ParseVersion(s)
+		if err != nil {
+			t.Fatalf("Error parsing version %q: %v", s, err)
+		}
+		versions = append(versions, v)
+	}
+
+	slices.SortFunc(versions, semver.Compare)
+	if got := fmt.Sprint(versions); got != "[1.0.0 1.2.0-rc.1 1.2.0 1.10.0]" {
+		t.Errorf("Expected %s, got %s", "[1.0.0 1.2.0-rc.1 1.2.0 1.10.0]", got)
+	}
+
+	target, _ := semver.ParseVersion("1.2.0")
+	if i, found := slices.BinarySearchFunc(versions, target, semver.Compare); i != 2 || !found {
+		t.Errorf("Expected 2 and true, got %d and %v", i, found)
+	}
+}
+
 func TestEquals(t *testing.T) {
 	tests := []struct {
 		v1       string
@@ -145,3 +312,85 @@ func TestEquals(t *testing.T) {
 		})
 	}
 }
+
+func TestMetricLabel(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected string
+	}{
+		{"1.2.3", "1.2.3"},
+		{"1.2.3-rc.1", "1.2.3-rc.1"},
+		{"1.2.3+build.42", "1.2.3"},
+		{"1.2.3-beta+exp.sha.5114f85", "1.2.3-beta"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			v, err := semver.ParseVersion(test.version)
+			if err != nil {
+				t.Fatalf("Error parsing version %q: %v", test.version, err)
+			}
+
+			if label := v.MetricLabel(); label != test.expected {
+				t.Errorf("Expected label %q, got %q", test.expected, label)
+			}
+		})
+	}
+}
+
+func TestParseVersionWithOptions_Epoch(t *testing.T) {
+	tests := []struct {
+		version     string
+		epoch       int
+		expected    string
+		expectedErr string
+	}{
+		{"1:2.3.4", 1, "1:2.3.4", ""},
+		{"0:2.3.4-rc.1", 0, "2.3.4-rc.1", ""},
+		{"2.3.4", 0, "2.3.4", ""},
+		{"01:2.3.4", 0, "", "invalid epoch: leading zero is not allowed (at position 0)"},
+		{"1.2:2.3.4", 0, "", "missing colon after epoch (at position 1)"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			v, err := semver.ParseVersionWithOptions(test.version, semver.ParseOptions{Epoch: true})
+			if err != nil {
+				if err.Error() != test.expectedErr {
+					t.Errorf("Expected error %q, got %q", test.expectedErr, err)
+				}
+				return
+			}
+			if v.Epoch != test.epoch {
+				t.Errorf("Expected epoch %d, got %d", test.epoch, v.Epoch)
+			}
+			if v.String() != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, v)
+			}
+		})
+	}
+
+	if _, err := semver.ParseVersion("1:2.3.4"); err == nil {
+		t.Errorf("Expected error for epoch without option")
+	}
+}
+
+func TestBefore_Epoch(t *testing.T) {
+	parse := func(s string) *semver.Version {
+		v, err := semver.ParseVersionWithOptions(s, semver.ParseOptions{Epoch: true})
+		if err != nil {
+			t.Fatalf("Error parsing version %q: %v", s, err)
+		}
+		return v
+	}
+
+	if !parse("2.0.0").Before(parse("1:1.0.0")) {
+		t.Errorf("Expected epoch to dominate the comparison")
+	}
+	if parse("1:1.0.0").Equals(parse("1.0.0")) {
+		t.Errorf("Expected versions with different epochs not to be equal")
+	}
+	if s := parse("1:1.0.0").Render(semver.RenderOptions{OmitEpoch: true}); s != "1.0.0" {
+		t.Errorf("Expected canonical version without epoch, got %q", s)
+	}
+}
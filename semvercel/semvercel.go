@@ -0,0 +1,195 @@
+// Package semvercel provides a CEL extension library for semantic versions.
+//
+// The library adds the following functions to a CEL environment:
+//
+//	semver(<string>) <Semver>                   parses a version, errors if it is invalid
+//	isSemver(<string>) <bool>                   determines if a string is a valid version
+//	<Semver>.major() <int>                      returns the major version
+//	<Semver>.minor() <int>                      returns the minor version
+//	<Semver>.patch() <int>                      returns the patch version
+//	<Semver>.prerelease() <string>              returns the pre-release or an empty string
+//	<Semver>.build() <string>                   returns the build metadata or an empty string
+//	<Semver>.compareTo(<Semver>) <int>          compares by precedence and returns -1, 0 or 1
+//	<Semver>.isLessThan(<Semver>) <bool>        determines if the version has a lower precedence
+//	<Semver>.isGreaterThan(<Semver>) <bool>     determines if the version has a higher precedence
+//	<Semver>.satisfies(<string>) <bool>         determines if the version matches a semver.Expr
+//
+// Equality (==) of two versions ignores the build metadata, like semver.Version.Equals.
+package semvercel
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+
+	"github.com/networkteam/semver"
+)
+
+// SemverType is the CEL type of a semantic version.
+var SemverType = cel.ObjectType("semver.Version")
+
+// Semver is the CEL value of a semantic version.
+type Semver struct {
+	*semver.Version
+}
+
+// ConvertToNative implements ref.Val.
+func (v Semver) ConvertToNative(typeDesc reflect.Type) (any, error) {
+	if reflect.TypeOf(v.Version).AssignableTo(typeDesc) {
+		return v.Version, nil
+	}
+	if reflect.TypeOf("").AssignableTo(typeDesc) {
+		return v.Version.String(), nil
+	}
+	return nil, fmt.Errorf("type conversion error from 'Semver' to '%v'", typeDesc)
+}
+
+// ConvertToType implements ref.Val.
+func (v Semver) ConvertToType(typeVal ref.Type) ref.Val {
+	switch typeVal {
+	case SemverType:
+		return v
+	case types.StringType:
+		return types.String(v.Version.String())
+	case types.TypeType:
+		return SemverType
+	}
+	return types.NewErr("type conversion error from '%s' to '%s'", SemverType, typeVal)
+}
+
+// Equal implements ref.Val.
+func (v Semver) Equal(other ref.Val) ref.Val {
+	o, ok := other.(Semver)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(other)
+	}
+	return types.Bool(v.Version.Equals(o.Version))
+}
+
+// Type implements ref.Val.
+func (v Semver) Type() ref.Type {
+	return SemverType
+}
+
+// Value implements ref.Val.
+func (v Semver) Value() any {
+	return v.Version
+}
+
+// Library returns an environment option that registers the semver type and functions.
+func Library() cel.EnvOption {
+	return cel.Lib(library{})
+}
+
+type library struct{}
+
+func (library) LibraryName() string {
+	return "semver"
+}
+
+func (library) CompileOptions() []cel.EnvOption {
+	return []cel.EnvOption{
+		cel.Variable(SemverType.TypeName(), types.NewTypeTypeWithParam(SemverType)),
+		cel.Function("semver",
+			cel.Overload("string_to_semver", []*cel.Type{cel.StringType}, SemverType,
+				cel.UnaryBinding(stringToSemver))),
+		cel.Function("isSemver",
+			cel.Overload("is_semver_string", []*cel.Type{cel.StringType}, cel.BoolType,
+				cel.UnaryBinding(isSemver))),
+		cel.Function("major",
+			cel.MemberOverload("semver_major", []*cel.Type{SemverType}, cel.IntType,
+				cel.UnaryBinding(field(func(v *semver.Version) ref.Val { return types.Int(v.Major) })))),
+		cel.Function("minor",
+			cel.MemberOverload("semver_minor", []*cel.Type{SemverType}, cel.IntType,
+				cel.UnaryBinding(field(func(v *semver.Version) ref.Val { return types.Int(v.Minor) })))),
+		cel.Function("patch",
+			cel.MemberOverload("semver_patch", []*cel.Type{SemverType}, cel.IntType,
+				cel.UnaryBinding(field(func(v *semver.Version) ref.Val { return types.Int(v.Patch) })))),
+		cel.Function("prerelease",
+			cel.MemberOverload("semver_prerelease", []*cel.Type{SemverType}, cel.StringType,
+				cel.UnaryBinding(field(func(v *semver.Version) ref.Val { return types.String(v.PreRelease) })))),
+		cel.Function("build",
+			cel.MemberOverload("semver_build", []*cel.Type{SemverType}, cel.StringType,
+				cel.UnaryBinding(field(func(v *semver.Version) ref.Val { return types.String(v.Build) })))),
+		cel.Function("compareTo",
+			cel.MemberOverload("semver_compare_to_semver", []*cel.Type{SemverType, SemverType}, cel.IntType,
+				cel.BinaryBinding(compare(func(result int) ref.Val { return types.Int(result) })))),
+		cel.Function("isLessThan",
+			cel.MemberOverload("semver_is_less_than_semver", []*cel.Type{SemverType, SemverType}, cel.BoolType,
+				cel.BinaryBinding(compare(func(result int) ref.Val { return types.Bool(result < 0) })))),
+		cel.Function("isGreaterThan",
+			cel.MemberOverload("semver_is_greater_than_semver", []*cel.Type{SemverType, SemverType}, cel.BoolType,
+				cel.BinaryBinding(compare(func(result int) ref.Val { return types.Bool(result > 0) })))),
+		cel.Function("satisfies",
+			cel.MemberOverload("semver_satisfies_string", []*cel.Type{SemverType, cel.StringType}, cel.BoolType,
+				cel.BinaryBinding(satisfies))),
+	}
+}
+
+func (library) ProgramOptions() []cel.ProgramOption {
+	return nil
+}
+
+func stringToSemver(arg ref.Val) ref.Val {
+	s, ok := arg.Value().(string)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(arg)
+	}
+	v, err := semver.ParseVersionWithOptions(s, semver.ParseOptions{})
+	if err != nil {
+		return types.WrapErr(err)
+	}
+	return Semver{Version: v}
+}
+
+func isSemver(arg ref.Val) ref.Val {
+	s, ok := arg.Value().(string)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(arg)
+	}
+	_, err := semver.ParseVersionWithOptions(s, semver.ParseOptions{})
+	return types.Bool(err == nil)
+}
+
+func field(get func(v *semver.Version) ref.Val) func(ref.Val) ref.Val {
+	return func(arg ref.Val) ref.Val {
+		v, ok := arg.(Semver)
+		if !ok {
+			return types.MaybeNoSuchOverloadErr(arg)
+		}
+		return get(v.Version)
+	}
+}
+
+func compare(result func(int) ref.Val) func(ref.Val, ref.Val) ref.Val {
+	return func(lhs, rhs ref.Val) ref.Val {
+		a, ok := lhs.(Semver)
+		if !ok {
+			return types.MaybeNoSuchOverloadErr(lhs)
+		}
+		b, ok := rhs.(Semver)
+		if !ok {
+			return types.MaybeNoSuchOverloadErr(rhs)
+		}
+		return result(a.Compare(b.Version))
+	}
+}
+
+func satisfies(lhs, rhs ref.Val) ref.Val {
+	v, ok := lhs.(Semver)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(lhs)
+	}
+	s, ok := rhs.Value().(string)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(rhs)
+	}
+	e, err := semver.CompileExpr(s)
+	if err != nil {
+		return types.WrapErr(err)
+	}
+	return types.Bool(e.Match(v.Version))
+}
@@ -0,0 +1,83 @@
+package semvercel_test
+
+import (
+	"testing"
+
+	"github.com/google/cel-go/cel"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/semvercel"
+)
+
+func TestLibrary(t *testing.T) {
+	tests := []struct {
+		expr     string
+		expected any
+	}{
+		{`semver("1.2.3").major()`, int64(1)},
+		{`semver("1.2.3").minor()`, int64(2)},
+		{`semver("1.2.3").patch()`, int64(3)},
+		{`semver("1.2.3-rc.1").prerelease()`, "rc.1"},
+		{`semver("1.2.3+linux").build()`, "linux"},
+		{`isSemver("1.2.3")`, true},
+		{`isSemver("1.2")`, false},
+		{`semver("1.0.0-rc.1").compareTo(semver("1.0.0"))`, int64(-1)},
+		{`semver("1.0.0").compareTo(semver("1.0.0+build"))`, int64(0)},
+		{`semver("1.10.0").isGreaterThan(semver("1.9.0"))`, true},
+		{`semver("1.10.0").isLessThan(semver("1.9.0"))`, false},
+		{`semver("1.0.0+a") == semver("1.0.0+b")`, true},
+		{`semver("2.1.0+linux").satisfies('major >= 2 && build contains "linux"')`, true},
+		{`version.satisfies('prerelease == ""')`, false},
+	}
+
+	version, err := semver.ParseVersion("3.0.0-beta.1")
+	if err != nil {
+		t.Fatalf("Error parsing version: %v", err)
+	}
+
+	env, err := cel.NewEnv(semvercel.Library(), cel.Variable("version", semvercel.SemverType))
+	if err != nil {
+		t.Fatalf("Error creating environment: %v", err)
+	}
+
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			ast, iss := env.Compile(test.expr)
+			if iss.Err() != nil {
+				t.Fatalf("Error compiling expression: %v", iss.Err())
+			}
+			prg, err := env.Program(ast)
+			if err != nil {
+				t.Fatalf("Error creating program: %v", err)
+			}
+
+			out, _, err := prg.Eval(map[string]any{"version": semvercel.Semver{Version: version}})
+			if err != nil {
+				t.Fatalf("Error evaluating expression: %v", err)
+			}
+			if out.Value() != test.expected {
+				t.Errorf("Expected %v, got %v", test.expected, out.Value())
+			}
+		})
+	}
+}
+
+func TestLibrary_InvalidVersion(t *testing.T) {
+	env, err := cel.NewEnv(semvercel.Library())
+	if err != nil {
+		t.Fatalf("Error creating environment: %v", err)
+	}
+	ast, iss := env.Compile(`semver("1.0").major()`)
+	if iss.Err() != nil {
+		t.Fatalf("Error compiling expression: %v", iss.Err())
+	}
+	prg, err := env.Program(ast)
+	if err != nil {
+		t.Fatalf("Error creating program: %v", err)
+	}
+
+	_, _, err = prg.Eval(cel.NoVars())
+	if err == nil {
+		t.Errorf("Expected error for invalid version")
+	}
+}
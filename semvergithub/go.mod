@@ -0,0 +1,5 @@
+module github.com/networkteam/semver/semvergithub
+
+go 1.21.5
+
+require github.com/networkteam/semver v1.1.0
@@ -0,0 +1,94 @@
+// Package semvergithub provides a semver.TagProvider for repositories on GitHub.
+package semvergithub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+)
+
+// DefaultBaseURL is the URL of the public GitHub API.
+const DefaultBaseURL = "https://api.github.com"
+
+// Provider lists the tags of a GitHub repository with the REST API.
+type Provider struct {
+	Owner string
+	Repo  string
+	// Token is an optional token for private repositories and higher rate limits.
+	Token string
+	// BaseURL is the URL of the API, it defaults to DefaultBaseURL (set it for GitHub Enterprise).
+	BaseURL string
+	// HTTPClient is the client for requests, it defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+var nextLinkPattern = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
+
+// ListTags implements semver.TagProvider, it follows the pagination of the API to return all tags.
+func (p *Provider) ListTags(ctx context.Context) ([]string, error) {
+	baseURL := p.BaseURL
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	client := p.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base URL: %w", err)
+	}
+
+	next := fmt.Sprintf("%s/repos/%s/%s/tags?per_page=100", baseURL, url.PathEscape(p.Owner), url.PathEscape(p.Repo))
+
+	var tags []string
+	for next != "" {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, next, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Accept", "application/vnd.github+json")
+		if p.Token != "" {
+			req.Header.Set("Authorization", "Bearer "+p.Token)
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("listing tags: %w", err)
+		}
+
+		var page []struct {
+			Name string `json:"name"`
+		}
+		err = decodeResponse(resp, &page)
+		if err != nil {
+			return nil, fmt.Errorf("listing tags: %w", err)
+		}
+		for _, tag := range page {
+			tags = append(tags, tag.Name)
+		}
+
+		next = ""
+		if m := nextLinkPattern.FindStringSubmatch(resp.Header.Get("Link")); m != nil {
+			// Only follow pages of the API, so the token is not sent to another host.
+			u, err := resp.Request.URL.Parse(m[1])
+			if err != nil || u.Scheme != base.Scheme || u.Host != base.Host {
+				return nil, fmt.Errorf("listing tags: next page %q is not on the host of the API", m[1])
+			}
+			next = u.String()
+		}
+	}
+	return tags, nil
+}
+
+func decodeResponse(resp *http.Response, v any) error {
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,84 @@
+package semvergithub_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/semvergithub"
+)
+
+func TestProvider_ListTags(t *testing.T) {
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/networkteam/semver/tags" {
+			http.NotFound(w, r)
+			return
+		}
+		if auth := r.Header.Get("Authorization"); auth != "Bearer secret" {
+			t.Errorf("Expected authorization header, got %q", auth)
+		}
+		switch r.URL.Query().Get("page") {
+		case "":
+			w.Header().Set("Link", fmt.Sprintf(`<%s/repos/networkteam/semver/tags?per_page=100&page=2>; rel="next"`, srv.URL))
+			_, _ = w.Write([]byte(`[{"name":"v1.0.0"},{"name":"v1.1.0"}]`))
+		case "2":
+			_, _ = w.Write([]byte(`[{"name":"v2.0.0-rc.1"},{"name":"latest"}]`))
+		}
+	}))
+	defer srv.Close()
+
+	p := &semvergithub.Provider{Owner: "networkteam", Repo: "semver", Token: "secret", BaseURL: srv.URL}
+
+	tags, err := p.ListTags(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if fmt.Sprint(tags) != "[v1.0.0 v1.1.0 v2.0.0-rc.1 latest]" {
+		t.Errorf("Expected all tags, got %v", tags)
+	}
+
+	v, err := semver.LatestRelease(context.Background(), p, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if v.String() != "1.1.0" {
+		t.Errorf("Expected latest release 1.1.0, got %s", v)
+	}
+}
+
+func TestProvider_ListTags_Error(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+
+	p := &semvergithub.Provider{Owner: "networkteam", Repo: "missing", BaseURL: srv.URL}
+
+	_, err := p.ListTags(context.Background())
+	if err == nil || err.Error() != "listing tags: unexpected status 404 Not Found" {
+		t.Errorf("Expected status error, got %v", err)
+	}
+}
+
+func TestProvider_ListTags_OtherHost(t *testing.T) {
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request to other host with authorization %q", r.Header.Get("Authorization"))
+	}))
+	defer other.Close()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Link", fmt.Sprintf(`<%s/tags?page=2>; rel="next"`, other.URL))
+		_, _ = w.Write([]byte(`[{"name":"v1.0.0"}]`))
+	}))
+	defer srv.Close()
+
+	p := &semvergithub.Provider{Owner: "networkteam", Repo: "semver", Token: "secret", BaseURL: srv.URL}
+
+	_, err := p.ListTags(context.Background())
+	expected := fmt.Sprintf("listing tags: next page %q is not on the host of the API", other.URL+"/tags?page=2")
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected host error, got %v", err)
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/networkteam/semver/semvergitlab
+
+go 1.21.5
@@ -0,0 +1,76 @@
+// Package semvergitlab provides a semver.TagProvider for projects on GitLab.
+package semvergitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// DefaultBaseURL is the URL of GitLab.com.
+const DefaultBaseURL = "https://gitlab.com"
+
+// Provider lists the tags of a GitLab project with the REST API.
+type Provider struct {
+	// Project is the ID or the full path (e.g. "group/project") of the project.
+	Project string
+	// Token is an optional private, project or group access token.
+	Token string
+	// BaseURL is the URL of the GitLab instance, it defaults to DefaultBaseURL.
+	BaseURL string
+	// HTTPClient is the client for requests, it defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// ListTags implements semver.TagProvider, it follows the pagination of the API to return all tags.
+func (p *Provider) ListTags(ctx context.Context) ([]string, error) {
+	baseURL := p.BaseURL
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	client := p.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	var tags []string
+	for page := "1"; page != ""; {
+		u := fmt.Sprintf("%s/api/v4/projects/%s/repository/tags?per_page=100&page=%s", baseURL, url.PathEscape(p.Project), url.QueryEscape(page))
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+		if err != nil {
+			return nil, err
+		}
+		if p.Token != "" {
+			req.Header.Set("PRIVATE-TOKEN", p.Token)
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("listing tags: %w", err)
+		}
+
+		var result []struct {
+			Name string `json:"name"`
+		}
+		err = decodeResponse(resp, &result)
+		if err != nil {
+			return nil, fmt.Errorf("listing tags: %w", err)
+		}
+		for _, tag := range result {
+			tags = append(tags, tag.Name)
+		}
+
+		page = resp.Header.Get("X-Next-Page")
+	}
+	return tags, nil
+}
+
+func decodeResponse(resp *http.Response, v any) error {
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
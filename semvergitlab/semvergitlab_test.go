@@ -0,0 +1,42 @@
+package semvergitlab_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/networkteam/semver/semvergitlab"
+)
+
+func TestProvider_ListTags(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/api/v4/projects/group%2Fproject/repository/tags" {
+			http.NotFound(w, r)
+			return
+		}
+		if token := r.Header.Get("PRIVATE-TOKEN"); token != "secret" {
+			t.Errorf("Expected token header, got %q", token)
+		}
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("X-Next-Page", "2")
+			_, _ = w.Write([]byte(`[{"name":"v1.0.0"},{"name":"v1.1.0"}]`))
+		case "2":
+			w.Header().Set("X-Next-Page", "")
+			_, _ = w.Write([]byte(`[{"name":"v0.9.0"}]`))
+		}
+	}))
+	defer srv.Close()
+
+	p := &semvergitlab.Provider{Project: "group/project", Token: "secret", BaseURL: srv.URL}
+
+	tags, err := p.ListTags(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if fmt.Sprint(tags) != "[v1.0.0 v1.1.0 v0.9.0]" {
+		t.Errorf("Expected all tags, got %v", tags)
+	}
+}
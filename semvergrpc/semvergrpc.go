@@ -0,0 +1,148 @@
+// Package semvergrpc provides gRPC interceptors to negotiate a client version.
+//
+// Clients attach their version to the outgoing metadata with UnaryClientInterceptor or StreamClientInterceptor.
+// Servers validate the version against a minimum version with UnaryServerInterceptor or StreamServerInterceptor, or
+// against a semver.Matcher with UnaryServerInterceptorMatching or StreamServerInterceptorMatching, and can retrieve it
+// in handlers with semver.FromContext.
+package semvergrpc
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/networkteam/semver"
+)
+
+// MetadataKey is the metadata key carrying the client version.
+const MetadataKey = "x-client-version"
+
+// UnaryClientInterceptor returns an interceptor that attaches the client version to the metadata of unary calls.
+func UnaryClientInterceptor(v *semver.Version) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(metadata.AppendToOutgoingContext(ctx, MetadataKey, v.String()), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor returns an interceptor that attaches the client version to the metadata of streams.
+func StreamClientInterceptor(v *semver.Version) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(metadata.AppendToOutgoingContext(ctx, MetadataKey, v.String()), desc, cc, method, opts...)
+	}
+}
+
+// UnaryServerInterceptor returns an interceptor that rejects unary calls with a client version below the minimum
+// version and adds the client version to the context of the handler.
+//
+// Calls without or with an invalid client version fail with codes.InvalidArgument, calls with a lower precedence
+// than the minimum version fail with codes.FailedPrecondition.
+func UnaryServerInterceptor(minimum *semver.Version) grpc.UnaryServerInterceptor {
+	return unaryServerInterceptor(minimumRequirement(minimum))
+}
+
+// StreamServerInterceptor returns an interceptor that rejects streams with a client version below the minimum
+// version and adds the client version to the context of the stream.
+//
+// Streams without or with an invalid client version fail with codes.InvalidArgument, streams with a lower precedence
+// than the minimum version fail with codes.FailedPrecondition.
+func StreamServerInterceptor(minimum *semver.Version) grpc.StreamServerInterceptor {
+	return streamServerInterceptor(minimumRequirement(minimum))
+}
+
+// UnaryServerInterceptorMatching returns an interceptor like UnaryServerInterceptor that rejects unary calls with a
+// client version that doesn't match m, e.g. an Expr like `version >= "1.2.0" && version < "3.0.0"`.
+func UnaryServerInterceptorMatching(m semver.Matcher) grpc.UnaryServerInterceptor {
+	return unaryServerInterceptor(matcherRequirement(m))
+}
+
+// StreamServerInterceptorMatching returns an interceptor like StreamServerInterceptor that rejects streams with a
+// client version that doesn't match m.
+func StreamServerInterceptorMatching(m semver.Matcher) grpc.StreamServerInterceptor {
+	return streamServerInterceptor(matcherRequirement(m))
+}
+
+// requirement checks client versions, missing describes the supported versions for calls without a client version.
+type requirement struct {
+	missing string
+	check   func(v *semver.Version) error
+}
+
+func minimumRequirement(minimum *semver.Version) requirement {
+	return requirement{
+		missing: fmt.Sprintf("minimum supported version is %s", minimum),
+		check:   semver.ClientSupport{MinClient: minimum}.CheckClientSupported,
+	}
+}
+
+func matcherRequirement(m semver.Matcher) requirement {
+	supported := "supported versions are " + fmt.Sprint(m)
+	if _, ok := m.(fmt.Stringer); !ok {
+		supported = ""
+	}
+	return requirement{
+		missing: supported,
+		check: func(v *semver.Version) error {
+			if m.Match(v) {
+				return nil
+			}
+			if supported == "" {
+				return fmt.Errorf("client version %s is not supported", v)
+			}
+			return fmt.Errorf("client version %s is not supported, %s", v, supported)
+		},
+	}
+}
+
+func unaryServerInterceptor(r requirement) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := negotiate(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func streamServerInterceptor(r requirement) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := negotiate(ss.Context(), r)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func negotiate(ctx context.Context, r requirement) (context.Context, error) {
+	values := metadata.ValueFromIncomingContext(ctx, MetadataKey)
+	if len(values) == 0 || values[0] == "" {
+		if r.missing == "" {
+			return nil, status.Error(codes.InvalidArgument, "client version is missing")
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "client version is missing, %s", r.missing)
+	}
+
+	v, err := semver.ParseVersionWithOptions(values[0], semver.ParseOptions{})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "client version is invalid: %v", err)
+	}
+
+	if err := r.check(v); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return semver.NewContext(ctx, v), nil
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,150 @@
+package semvergrpc_test
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/semvergrpc"
+)
+
+func mustParse(t *testing.T, version string) *semver.Version {
+	t.Helper()
+	v, err := semver.ParseVersion(version)
+	if err != nil {
+		t.Fatalf("Error parsing version %q: %v", version, err)
+	}
+	return v
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	interceptor := semvergrpc.UnaryClientInterceptor(mustParse(t, "1.2.3"))
+
+	var got []string
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		got = md.Get(semvergrpc.MetadataKey)
+		return nil
+	}
+
+	if err := interceptor(context.Background(), "/test", nil, nil, nil, invoker); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(got) != 1 || got[0] != "1.2.3" {
+		t.Errorf("Expected metadata %q, got %q", "1.2.3", got)
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := semvergrpc.UnaryServerInterceptor(mustParse(t, "1.2.0"))
+
+	tests := []struct {
+		name         string
+		version      string
+		expectedCode codes.Code
+	}{
+		{"supported", "1.2.0", codes.OK},
+		{"missing", "", codes.InvalidArgument},
+		{"invalid", "1.2", codes.InvalidArgument},
+		{"too old", "1.1.9", codes.FailedPrecondition},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			if test.version != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(semvergrpc.MetadataKey, test.version))
+			}
+
+			var got *semver.Version
+			handler := func(ctx context.Context, req any) (any, error) {
+				got, _ = semver.FromContext(ctx)
+				return nil, nil
+			}
+
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/test"}, handler)
+			if code := status.Code(err); code != test.expectedCode {
+				t.Fatalf("Expected code %v, got %v (%v)", test.expectedCode, code, err)
+			}
+			if test.expectedCode == codes.OK && (got == nil || got.String() != test.version) {
+				t.Errorf("Expected version %q in handler context, got %v", test.version, got)
+			}
+		})
+	}
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	interceptor := semvergrpc.StreamServerInterceptor(mustParse(t, "1.2.0"))
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(semvergrpc.MetadataKey, "2.0.0"))
+
+	var got *semver.Version
+	handler := func(srv any, stream grpc.ServerStream) error {
+		got, _ = semver.FromContext(stream.Context())
+		return nil
+	}
+
+	if err := interceptor(nil, &testServerStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: "/test"}, handler); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got == nil || got.String() != "2.0.0" {
+		t.Errorf("Expected version 2.0.0 in stream context, got %v", got)
+	}
+}
+
+func TestUnaryServerInterceptorMatching(t *testing.T) {
+	expr, err := semver.CompileExpr(`version >= "1.2.0" && version < "3.0.0"`)
+	if err != nil {
+		t.Fatalf("Error compiling expression: %v", err)
+	}
+	interceptor := semvergrpc.UnaryServerInterceptorMatching(expr)
+
+	tests := []struct {
+		name            string
+		version         string
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{"supported", "2.5.0", codes.OK, ""},
+		{"missing", "", codes.InvalidArgument,
+			`client version is missing, supported versions are version >= "1.2.0" && version < "3.0.0"`},
+		{"too old", "1.1.9", codes.FailedPrecondition,
+			`client version 1.1.9 is not supported, supported versions are version >= "1.2.0" && version < "3.0.0"`},
+		{"too new", "3.0.0", codes.FailedPrecondition,
+			`client version 3.0.0 is not supported, supported versions are version >= "1.2.0" && version < "3.0.0"`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			if test.version != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(semvergrpc.MetadataKey, test.version))
+			}
+
+			handler := func(ctx context.Context, req any) (any, error) {
+				return nil, nil
+			}
+
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/test"}, handler)
+			if code := status.Code(err); code != test.expectedCode {
+				t.Fatalf("Expected code %v, got %v (%v)", test.expectedCode, code, err)
+			}
+			if message := status.Convert(err).Message(); message != test.expectedMessage {
+				t.Errorf("Expected message %q, got %q", test.expectedMessage, message)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+// Package semverhttp provides net/http middleware to enforce a minimum client version or a version requirement.
+package semverhttp
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/networkteam/semver"
+)
+
+// Extractor extracts the client version from a request, it returns an empty string if the request has no version.
+type Extractor func(r *http.Request) string
+
+// FromHeader extracts the client version from a request header.
+func FromHeader(name string) Extractor {
+	return func(r *http.Request) string {
+		return r.Header.Get(name)
+	}
+}
+
+// FromQuery extracts the client version from a query parameter.
+func FromQuery(name string) Extractor {
+	return func(r *http.Request) string {
+		return r.URL.Query().Get(name)
+	}
+}
+
+// FromUserAgent extracts the client version of a product from the User-Agent header (e.g. "myapp/1.2.3 (linux)").
+func FromUserAgent(product string) Extractor {
+	return func(r *http.Request) string {
+		for _, token := range strings.Fields(r.UserAgent()) {
+			name, version, found := strings.Cut(token, "/")
+			if found && name == product {
+				return version
+			}
+		}
+		return ""
+	}
+}
+
+// FirstOf extracts the client version with the first extractor that finds one.
+func FirstOf(extractors ...Extractor) Extractor {
+	return func(r *http.Request) string {
+		for _, extract := range extractors {
+			if s := extract(r); s != "" {
+				return s
+			}
+		}
+		return ""
+	}
+}
+
+// ErrorResponse is the JSON body of a rejected request.
+type ErrorResponse struct {
+	// Error is a stable code for the reason: "client_version_missing", "client_version_invalid" or
+	// "client_version_unsupported".
+	Error         string `json:"error"`
+	Message       string `json:"message"`
+	ClientVersion string `json:"clientVersion,omitempty"`
+	// MinimumVersion is set by RequireMinimum.
+	MinimumVersion string `json:"minimumVersion,omitempty"`
+	// Requirement is set by Require if the matcher implements fmt.Stringer, e.g. `version >= "1.2.0"`.
+	Requirement string `json:"requirement,omitempty"`
+}
+
+// RequireMinimum returns a middleware that rejects requests with a client version below the minimum version.
+//
+// Requests without or with an invalid client version are rejected with 400 Bad Request, requests with a lower
+// precedence than the minimum version are rejected with 426 Upgrade Required. The response body is an ErrorResponse.
+// For accepted requests the client version is added to the request context and can be retrieved with
+// semver.FromContext.
+func RequireMinimum(minimum *semver.Version, extract Extractor) func(http.Handler) http.Handler {
+	support := semver.ClientSupport{MinClient: minimum}
+	return require(extract, ErrorResponse{MinimumVersion: minimum.String()}, support.CheckClientSupported)
+}
+
+// Require returns a middleware that rejects requests with a client version that doesn't match m, e.g. an Expr like
+// `version >= "1.2.0" && version < "3.0.0"`. Requests are rejected like by RequireMinimum, with 426 Upgrade Required
+// for all client versions that don't match.
+func Require(m semver.Matcher, extract Extractor) func(http.Handler) http.Handler {
+	var requirement string
+	if stringer, ok := m.(fmt.Stringer); ok {
+		requirement = stringer.String()
+	}
+	return require(extract, ErrorResponse{Requirement: requirement}, func(v *semver.Version) error {
+		if m.Match(v) {
+			return nil
+		}
+		if requirement == "" {
+			return fmt.Errorf("client version %s is not supported", v)
+		}
+		return fmt.Errorf("client version %s is not supported, supported versions are %s", v, requirement)
+	})
+}
+
+// require returns a middleware that checks the client version with check, the fields of base describing the
+// requirement are set in all error responses.
+func require(extract Extractor, base ErrorResponse, check func(v *semver.Version) error) func(http.Handler) http.Handler {
+	reject := func(w http.ResponseWriter, status int, code, message, clientVersion string) {
+		resp := base
+		resp.Error = code
+		resp.Message = message
+		resp.ClientVersion = clientVersion
+		writeError(w, status, resp)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			s := extract(r)
+			if s == "" {
+				reject(w, http.StatusBadRequest, "client_version_missing", "client version is missing", "")
+				return
+			}
+
+			v, err := semver.ParseVersionWithOptions(s, semver.ParseOptions{})
+			if err != nil {
+				reject(w, http.StatusBadRequest, "client_version_invalid", fmt.Sprintf("client version is invalid: %v", err), s)
+				return
+			}
+
+			if err := check(v); err != nil {
+				reject(w, http.StatusUpgradeRequired, "client_version_unsupported", err.Error(), s)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(semver.NewContext(r.Context(), v)))
+		})
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, resp ErrorResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+	// Keep operators of requirements like >= readable.
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(resp)
+}
@@ -0,0 +1,113 @@
+package semverhttp_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/semverhttp"
+)
+
+func TestRequireMinimum(t *testing.T) {
+	minimum, err := semver.ParseVersion("1.2.0")
+	if err != nil {
+		t.Fatalf("Error parsing version: %v", err)
+	}
+
+	handler := semverhttp.RequireMinimum(minimum, semverhttp.FirstOf(
+		semverhttp.FromHeader("X-Client-Version"),
+		semverhttp.FromQuery("client_version"),
+		semverhttp.FromUserAgent("myapp"),
+	))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v, _ := semver.FromContext(r.Context())
+		_, _ = w.Write([]byte(v.String()))
+	}))
+
+	tests := []struct {
+		name         string
+		header       string
+		target       string
+		userAgent    string
+		expectedCode int
+		expectedBody string
+	}{
+		{"header", "1.2.0", "/", "", http.StatusOK, "1.2.0"},
+		{"query", "", "/?client_version=1.3.0-rc.1", "", http.StatusOK, "1.3.0-rc.1"},
+		{"user agent", "", "/", "curl/8.0 myapp/2.0.0 (linux)", http.StatusOK, "2.0.0"},
+		{"missing", "", "/", "curl/8.0", http.StatusBadRequest,
+			`{"error":"client_version_missing","message":"client version is missing","minimumVersion":"1.2.0"}` + "\n"},
+		{"invalid", "1.2", "/", "", http.StatusBadRequest,
+			`{"error":"client_version_invalid","message":"client version is invalid: invalid version core: missing dot separator (at position 3)","clientVersion":"1.2","minimumVersion":"1.2.0"}` + "\n"},
+		{"too old", "1.2.0-rc.1", "/", "", http.StatusUpgradeRequired,
+			`{"error":"client_version_unsupported","message":"client version 1.2.0-rc.1 is not supported, please upgrade to 1.2.0 or later","clientVersion":"1.2.0-rc.1","minimumVersion":"1.2.0"}` + "\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, test.target, nil)
+			if test.header != "" {
+				req.Header.Set("X-Client-Version", test.header)
+			}
+			if test.userAgent != "" {
+				req.Header.Set("User-Agent", test.userAgent)
+			}
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != test.expectedCode {
+				t.Errorf("Expected status %d, got %d", test.expectedCode, rec.Code)
+			}
+			if rec.Body.String() != test.expectedBody {
+				t.Errorf("Expected body %q, got %q", test.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestRequire(t *testing.T) {
+	expr, err := semver.CompileExpr(`version >= "1.2.0" && version < "3.0.0"`)
+	if err != nil {
+		t.Fatalf("Error compiling expression: %v", err)
+	}
+
+	handler := semverhttp.Require(expr, semverhttp.FromHeader("X-Client-Version"))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v, _ := semver.FromContext(r.Context())
+		_, _ = w.Write([]byte(v.String()))
+	}))
+
+	tests := []struct {
+		name         string
+		header       string
+		expectedCode int
+		expectedBody string
+	}{
+		{"match", "2.5.0", http.StatusOK, "2.5.0"},
+		{"missing", "", http.StatusBadRequest,
+			`{"error":"client_version_missing","message":"client version is missing","requirement":"version >= \"1.2.0\" && version < \"3.0.0\""}` + "\n"},
+		{"too old", "1.1.0", http.StatusUpgradeRequired,
+			`{"error":"client_version_unsupported","message":"client version 1.1.0 is not supported, supported versions are version >= \"1.2.0\" && version < \"3.0.0\"","clientVersion":"1.1.0","requirement":"version >= \"1.2.0\" && version < \"3.0.0\""}` + "\n"},
+		{"too new", "3.0.0", http.StatusUpgradeRequired,
+			`{"error":"client_version_unsupported","message":"client version 3.0.0 is not supported, supported versions are version >= \"1.2.0\" && version < \"3.0.0\"","clientVersion":"3.0.0","requirement":"version >= \"1.2.0\" && version < \"3.0.0\""}` + "\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if test.header != "" {
+				req.Header.Set("X-Client-Version", test.header)
+			}
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != test.expectedCode {
+				t.Errorf("Expected status %d, got %d", test.expectedCode, rec.Code)
+			}
+			if rec.Body.String() != test.expectedBody {
+				t.Errorf("Expected body %q, got %q", test.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+//go:build js && wasm
+
+// Command semverjs exposes the semver package to JavaScript when compiled to WebAssembly:
+//
+//	GOOS=js GOARCH=wasm go build -o semver.wasm ./semverjs
+//
+// After running the module (see wasm_exec.js of the Go distribution), the global object semver provides:
+//
+//	semver.parse(version)            returns {major, minor, patch, prerelease, build}
+//	semver.compare(a, b)             returns -1, 0 or 1 by precedence
+//	semver.satisfies(version, expr)  returns true if the version matches a semver.Expr
+//
+// Functions return an Error object instead of throwing for invalid input.
+package main
+
+import (
+	"errors"
+	"syscall/js"
+
+	"github.com/networkteam/semver"
+)
+
+func main() {
+	js.Global().Set("semver", js.ValueOf(map[string]any{
+		"parse":     js.FuncOf(parse),
+		"compare":   js.FuncOf(compare),
+		"satisfies": js.FuncOf(satisfies),
+	}))
+
+	// Keep the module running to serve calls
+	select {}
+}
+
+func parse(_ js.Value, args []js.Value) any {
+	v, err := parseArg(args, 0)
+	if err != nil {
+		return jsError(err)
+	}
+	return map[string]any{
+		"major":      v.Major,
+		"minor":      v.Minor,
+		"patch":      v.Patch,
+		"prerelease": v.PreRelease,
+		"build":      v.Build,
+	}
+}
+
+func compare(_ js.Value, args []js.Value) any {
+	a, err := parseArg(args, 0)
+	if err != nil {
+		return jsError(err)
+	}
+	b, err := parseArg(args, 1)
+	if err != nil {
+		return jsError(err)
+	}
+	return a.Compare(b)
+}
+
+func satisfies(_ js.Value, args []js.Value) any {
+	v, err := parseArg(args, 0)
+	if err != nil {
+		return jsError(err)
+	}
+	if len(args) < 2 || args[1].Type() != js.TypeString {
+		return jsError(errMissingArgument)
+	}
+	e, err := semver.CompileExpr(args[1].String())
+	if err != nil {
+		return jsError(err)
+	}
+	return e.Match(v)
+}
+
+var errMissingArgument = errors.New("missing string argument")
+
+func parseArg(args []js.Value, i int) (*semver.Version, error) {
+	if len(args) <= i || args[i].Type() != js.TypeString {
+		return nil, errMissingArgument
+	}
+	return semver.ParseVersionWithOptions(args[i].String(), semver.ParseOptions{})
+}
+
+func jsError(err error) js.Value {
+	return js.Global().Get("Error").New(err.Error())
+}
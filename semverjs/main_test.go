@@ -0,0 +1,48 @@
+//go:build js && wasm
+
+package main
+
+import (
+	"syscall/js"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{"1.0.0-rc.1", "1.0.0", -1},
+		{"1.0.0+a", "1.0.0+b", 0},
+		{"1.10.0", "1.9.0", 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.a+" <=> "+test.b, func(t *testing.T) {
+			result := compare(js.Undefined(), []js.Value{js.ValueOf(test.a), js.ValueOf(test.b)})
+			if result != test.expected {
+				t.Errorf("Expected %d, got %v", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	result := js.ValueOf(parse(js.Undefined(), []js.Value{js.ValueOf("1.2.3-rc.1+build")}))
+	if result.Get("minor").Int() != 2 || result.Get("prerelease").String() != "rc.1" {
+		t.Errorf("Expected parsed version, got %v", result)
+	}
+
+	result = js.ValueOf(parse(js.Undefined(), []js.Value{js.ValueOf("1.2")}))
+	if !result.InstanceOf(js.Global().Get("Error")) {
+		t.Errorf("Expected Error for invalid version, got %v", result)
+	}
+}
+
+func TestSatisfies(t *testing.T) {
+	result := satisfies(js.Undefined(), []js.Value{js.ValueOf("2.1.0"), js.ValueOf(`major >= 2`)})
+	if result != true {
+		t.Errorf("Expected true, got %v", result)
+	}
+}
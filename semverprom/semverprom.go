@@ -0,0 +1,96 @@
+// Package semverprom exposes semantic versions as Prometheus metrics.
+package semverprom
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/networkteam/semver"
+)
+
+// NewBuildInfo returns a gauge named <namespace>_build_info that is always 1 and carries the version as labels:
+//
+//	app_build_info{version="1.2.3-rc.1",major="1",minor="2",build="sha.5114f85"} 1
+//
+// The version label is rendered with semver.Version.MetricLabel, the build metadata is exposed separately.
+func NewBuildInfo(namespace string, v *semver.Version) prometheus.Collector {
+	return prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "build_info",
+		Help:      "A metric with a constant '1' value labeled by the version of the application.",
+		ConstLabels: prometheus.Labels{
+			"version": v.MetricLabel(),
+			"major":   strconv.Itoa(v.Major),
+			"minor":   strconv.Itoa(v.Minor),
+			"build":   v.Build,
+		},
+	}, func() float64 { return 1 })
+}
+
+// RegisterBuildInfo registers the build info gauge of NewBuildInfo with the registerer.
+func RegisterBuildInfo(reg prometheus.Registerer, namespace string, v *semver.Version) error {
+	return reg.Register(NewBuildInfo(namespace, v))
+}
+
+// ParseMetrics counts parsed versions, it implements semver.ParseObserver and prometheus.Collector:
+//
+//	app_semver_parses_total 42
+//	app_semver_parse_failures_total{kind="leading_zero"} 3
+//
+// Wire it with semver.SetParseObserver and register it with a registerer.
+type ParseMetrics struct {
+	parses   prometheus.Counter
+	failures *prometheus.CounterVec
+}
+
+var _ semver.ParseObserver = (*ParseMetrics)(nil)
+
+// NewParseMetrics returns metrics for parsed versions in the namespace.
+func NewParseMetrics(namespace string) *ParseMetrics {
+	return &ParseMetrics{
+		parses: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "semver_parses_total",
+			Help:      "Total number of parsed versions.",
+		}),
+		failures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "semver_parse_failures_total",
+			Help:      "Total number of versions that failed to parse by kind of error (see semver.ErrorKind).",
+		}, []string{"kind"}),
+	}
+}
+
+// ObserveParse implements semver.ParseObserver.
+func (m *ParseMetrics) ObserveParse(err error) {
+	m.parses.Inc()
+	if err != nil {
+		m.failures.WithLabelValues(errorKind(err)).Inc()
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (m *ParseMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.parses.Describe(ch)
+	m.failures.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (m *ParseMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.parses.Collect(ch)
+	m.failures.Collect(ch)
+}
+
+func errorKind(err error) string {
+	var nonASCIIErr *semver.NonASCIIError
+	if errors.As(err, &nonASCIIErr) {
+		return "non_ascii"
+	}
+	var parseErr *semver.ParseError
+	if errors.As(err, &parseErr) && parseErr.Kind != "" {
+		return string(parseErr.Kind)
+	}
+	return "other"
+}
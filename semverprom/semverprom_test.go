@@ -0,0 +1,61 @@
+package semverprom_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/semverprom"
+)
+
+func TestRegisterBuildInfo(t *testing.T) {
+	v, err := semver.ParseVersion("1.2.3-rc.1+sha.5114f85")
+	if err != nil {
+		t.Fatalf("Error parsing version: %v", err)
+	}
+
+	reg := prometheus.NewPedanticRegistry()
+	if err := semverprom.RegisterBuildInfo(reg, "app", v); err != nil {
+		t.Fatalf("Error registering build info: %v", err)
+	}
+
+	expected := `
+# HELP app_build_info A metric with a constant '1' value labeled by the version of the application.
+# TYPE app_build_info gauge
+app_build_info{build="sha.5114f85",major="1",minor="2",version="1.2.3-rc.1"} 1
+`
+	if err := testutil.GatherAndCompare(reg, strings.NewReader(expected), "app_build_info"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestParseMetrics(t *testing.T) {
+	m := semverprom.NewParseMetrics("app")
+	semver.SetParseObserver(m)
+	defer semver.SetParseObserver(nil)
+
+	reg := prometheus.NewPedanticRegistry()
+	if err := reg.Register(m); err != nil {
+		t.Fatalf("Error registering metrics: %v", err)
+	}
+
+	for _, s := range []string{"1.0.0", "1.0", "１.0.0", "2.0.0-rc.1"} {
+		_, _ = semver.ParseVersion(s)
+	}
+
+	expected := `
+# HELP app_semver_parse_failures_total Total number of versions that failed to parse by kind of error (see semver.ErrorKind).
+# TYPE app_semver_parse_failures_total counter
+app_semver_parse_failures_total{kind="non_ascii"} 1
+app_semver_parse_failures_total{kind="missing_dot"} 1
+# HELP app_semver_parses_total Total number of parsed versions.
+# TYPE app_semver_parses_total counter
+app_semver_parses_total 4
+`
+	if err := testutil.GatherAndCompare(reg, strings.NewReader(expected), "app_semver_parses_total", "app_semver_parse_failures_total"); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,8 @@
+module github.com/networkteam/semver/semverrapid
+
+go 1.21.5
+
+require (
+	github.com/networkteam/semver v1.1.0
+	pgregory.net/rapid v1.2.0
+)
@@ -0,0 +1,45 @@
+// Package semverrapid provides generators of semantic versions for property-based tests with rapid.
+package semverrapid
+
+import (
+	"strconv"
+	"strings"
+
+	"pgregory.net/rapid"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/semvertest"
+)
+
+// Version returns a generator of valid versions, configured like semvertest.Generate.
+// Drawn versions shrink towards 0.0.0 without pre-release and build metadata.
+func Version(opts semvertest.Options) *rapid.Generator[*semver.Version] {
+	maxComponent := opts.MaxComponent
+	if maxComponent <= 0 {
+		maxComponent = semvertest.DefaultMaxComponent
+	}
+	component := rapid.IntRange(0, maxComponent)
+
+	preReleaseIdentifier := rapid.OneOf(
+		rapid.Custom(func(t *rapid.T) string {
+			return strconv.Itoa(component.Draw(t, "numeric"))
+		}),
+		rapid.StringMatching(`[0-9A-Za-z-]{0,7}[A-Za-z-][0-9A-Za-z-]{0,7}`),
+	)
+	buildIdentifier := rapid.StringMatching(`[0-9A-Za-z-]{1,8}`)
+
+	return rapid.Custom(func(t *rapid.T) *semver.Version {
+		v := &semver.Version{
+			Major: component.Draw(t, "major"),
+			Minor: component.Draw(t, "minor"),
+			Patch: component.Draw(t, "patch"),
+		}
+		if opts.PreRelease {
+			v.PreRelease = strings.Join(rapid.SliceOfN(preReleaseIdentifier, 0, 3).Draw(t, "preRelease"), ".")
+		}
+		if opts.Build {
+			v.Build = strings.Join(rapid.SliceOfN(buildIdentifier, 0, 3).Draw(t, "build"), ".")
+		}
+		return v
+	})
+}
@@ -0,0 +1,30 @@
+package semverrapid_test
+
+import (
+	"testing"
+
+	"pgregory.net/rapid"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/semverrapid"
+	"github.com/networkteam/semver/semvertest"
+)
+
+func TestVersion(t *testing.T) {
+	gen := semverrapid.Version(semvertest.Options{MaxComponent: 5, PreRelease: true, Build: true})
+
+	rapid.Check(t, func(t *rapid.T) {
+		v := gen.Draw(t, "version")
+
+		parsed, err := semver.ParseVersion(v.String())
+		if err != nil {
+			t.Fatalf("Expected valid version, got %q: %v", v, err)
+		}
+		if !parsed.Equals(v) || parsed.Build != v.Build {
+			t.Fatalf("Expected %q to round-trip, got %q", v, parsed)
+		}
+		if v.Major > 5 || v.Minor > 5 || v.Patch > 5 {
+			t.Fatalf("Expected components to be at most 5, got %s", v)
+		}
+	})
+}
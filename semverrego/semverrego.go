@@ -0,0 +1,135 @@
+// Package semverrego encodes versions as JSON documents for use in OPA/Rego policies.
+//
+// The encoded document contains the pre-split identifiers of a version and a precedence key that can be compared
+// with Rego's built-in comparison operators, so policies don't need to re-implement SemVer precedence:
+//
+//	input.client.key >= data.minimum.key
+//
+// Module returns a small reference Rego library with helpers built on top of the key.
+package semverrego
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/networkteam/semver"
+)
+
+// Version is the JSON document of an encoded version.
+type Version struct {
+	// Version is the string representation of the version.
+	Version string `json:"version"`
+	// Epoch is the optional epoch of distribution-style versions, it is zero for plain semantic versions.
+	Epoch int `json:"epoch"`
+	Major int `json:"major"`
+	Minor int `json:"minor"`
+	Patch int `json:"patch"`
+	// PreRelease contains the pre-release identifiers, it is empty for a stable version.
+	PreRelease []Identifier `json:"prerelease"`
+	// Build contains the build metadata identifiers.
+	Build []string `json:"build"`
+	// Key is the precedence key of the version. Comparing keys with Rego's comparison operators (which compare
+	// arrays element by element) yields the SemVer precedence of the versions.
+	Key []any `json:"key"`
+}
+
+// Identifier is a pre-release identifier.
+type Identifier struct {
+	Value string `json:"value"`
+	// Numeric is true if the identifier consists of digits only and is compared numerically.
+	Numeric bool `json:"numeric"`
+	// Number is the numeric value of a numeric identifier.
+	Number int `json:"number"`
+}
+
+// Encode encodes a version as a document.
+func Encode(v *semver.Version) Version {
+	doc := Version{
+		Version:    v.String(),
+		Epoch:      v.Epoch,
+		Major:      v.Major,
+		Minor:      v.Minor,
+		Patch:      v.Patch,
+		PreRelease: []Identifier{},
+		Build:      []string{},
+	}
+
+	// A version without pre-release has a higher precedence than any pre-release: [1] > [0, ...]
+	preReleaseKey := []any{1}
+	if v.PreRelease != "" {
+		preReleaseKey = []any{0}
+		for _, s := range strings.Split(v.PreRelease, ".") {
+			id := Identifier{Value: s}
+			if n, ok := numericIdentifier(s); ok {
+				id.Numeric = true
+				id.Number = n
+			}
+			doc.PreRelease = append(doc.PreRelease, id)
+
+			// Numeric identifiers have a lower precedence than alphanumeric identifiers: [0, n] < [1, s]
+			if id.Numeric {
+				preReleaseKey = append(preReleaseKey, []any{0, id.Number})
+			} else {
+				preReleaseKey = append(preReleaseKey, []any{1, id.Value})
+			}
+		}
+	}
+	if v.Build != "" {
+		doc.Build = strings.Split(v.Build, ".")
+	}
+	// The epoch dominates the precedence like in Version.Compare.
+	doc.Key = []any{v.Epoch, v.Major, v.Minor, v.Patch, preReleaseKey}
+
+	return doc
+}
+
+// numericIdentifier returns the number of an identifier that is compared numerically: it consists of ASCII digits
+// only and has no leading zero, so identifiers like "-5" or "+5" are alphanumeric.
+func numericIdentifier(s string) (int, bool) {
+	if s == "" || s[0] == '0' && len(s) > 1 {
+		return 0, false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return 0, false
+		}
+	}
+	n, err := strconv.Atoi(s)
+	return n, err == nil
+}
+
+// Module returns the source of a Rego module with the given package name that provides helpers for encoded versions.
+func Module(pkg string) string {
+	return "package " + pkg + `
+
+import rego.v1
+
+# compare returns -1, 0 or 1 if the precedence of version a is lower, equal or higher than of version b.
+compare(a, b) := -1 if a.key < b.key
+
+compare(a, b) := 0 if a.key == b.key
+
+compare(a, b) := 1 if a.key > b.key
+
+# less_than is true if the precedence of version a is lower than of version b.
+less_than(a, b) if a.key < b.key
+
+# greater_than is true if the precedence of version a is higher than of version b.
+greater_than(a, b) if a.key > b.key
+
+# equal is true if the versions have the same precedence (ignoring the build metadata).
+equal(a, b) if a.key == b.key
+
+# at_least is true if the precedence of version a is higher than or equal to version b.
+at_least(a, b) if a.key >= b.key
+
+# at_most is true if the precedence of version a is lower than or equal to version b.
+at_most(a, b) if a.key <= b.key
+
+# is_stable is true if the version has no pre-release.
+is_stable(a) if count(a.prerelease) == 0
+
+# is_prerelease is true if the version has a pre-release.
+is_prerelease(a) if count(a.prerelease) > 0
+`
+}
@@ -0,0 +1,141 @@
+package semverrego_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/semverrego"
+)
+
+func TestEncode(t *testing.T) {
+	v, err := semver.ParseVersion("1.2.3-rc.1+linux.amd64")
+	if err != nil {
+		t.Fatalf("Error parsing version: %v", err)
+	}
+
+	data, err := json.Marshal(semverrego.Encode(v))
+	if err != nil {
+		t.Fatalf("Error marshalling document: %v", err)
+	}
+
+	expected := `{"version":"1.2.3-rc.1+linux.amd64","epoch":0,"major":1,"minor":2,"patch":3,` +
+		`"prerelease":[{"value":"rc","numeric":false,"number":0},{"value":"1","numeric":true,"number":1}],` +
+		`"build":["linux","amd64"],"key":[0,1,2,3,[0,[1,"rc"],[0,1]]]}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+}
+
+func TestEncode_KeyOrder(t *testing.T) {
+	// Versions in ascending order of precedence (from the SemVer spec)
+	versions := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"1.0.1",
+		"1.1.0",
+		"2.0.0",
+		"1:0.1.0",
+		"2:0.0.1-rc.1",
+	}
+
+	opts := semver.ParseOptions{Epoch: true}
+	for i := 0; i < len(versions)-1; i++ {
+		a, _ := semver.ParseVersionWithOptions(versions[i], opts)
+		b, _ := semver.ParseVersionWithOptions(versions[i+1], opts)
+		keyA := roundTrip(t, semverrego.Encode(a).Key)
+		keyB := roundTrip(t, semverrego.Encode(b).Key)
+
+		if result := compareRego(keyA, keyB); result != -1 {
+			t.Errorf("Expected key of %s to be less than key of %s, got %d", a, b, result)
+		}
+		if result := compareRego(keyA, keyA); result != 0 {
+			t.Errorf("Expected key of %s to be equal to itself, got %d", a, result)
+		}
+	}
+}
+
+func TestEncode_HyphenIdentifier(t *testing.T) {
+	a, _ := semver.ParseVersion("1.0.0-1")
+	b, _ := semver.ParseVersion("1.0.0--5")
+
+	doc := semverrego.Encode(b)
+	if doc.PreRelease[0].Numeric {
+		t.Errorf("Expected identifier %q not to be numeric", doc.PreRelease[0].Value)
+	}
+	// Numeric identifiers have a lower precedence than alphanumeric identifiers
+	if result := compareRego(roundTrip(t, semverrego.Encode(a).Key), roundTrip(t, doc.Key)); result != -1 {
+		t.Errorf("Expected key of %s to be less than key of %s, got %d", a, b, result)
+	}
+}
+
+func TestModule(t *testing.T) {
+	m := semverrego.Module("lib.semver")
+	if !strings.HasPrefix(m, "package lib.semver\n") {
+		t.Errorf("Expected module to start with package declaration, got %q", m)
+	}
+}
+
+func roundTrip(t *testing.T, key []any) any {
+	t.Helper()
+	data, err := json.Marshal(key)
+	if err != nil {
+		t.Fatalf("Error marshalling key: %v", err)
+	}
+	var result any
+	if err := json.Unmarshal(data, &result); err != nil {
+		t.Fatalf("Error unmarshalling key: %v", err)
+	}
+	return result
+}
+
+// compareRego compares JSON values like OPA does for numbers, strings and arrays.
+func compareRego(a, b any) int {
+	switch a := a.(type) {
+	case float64:
+		if b, ok := b.(float64); ok {
+			switch {
+			case a < b:
+				return -1
+			case a > b:
+				return 1
+			}
+			return 0
+		}
+		// Numbers sort before strings and arrays
+		return -1
+	case string:
+		switch b := b.(type) {
+		case float64:
+			return 1
+		case string:
+			return strings.Compare(a, b)
+		}
+		return -1
+	case []any:
+		b, ok := b.([]any)
+		if !ok {
+			return 1
+		}
+		for i := 0; i < len(a) && i < len(b); i++ {
+			if result := compareRego(a[i], b[i]); result != 0 {
+				return result
+			}
+		}
+		switch {
+		case len(a) < len(b):
+			return -1
+		case len(a) > len(b):
+			return 1
+		}
+		return 0
+	}
+	return 0
+}
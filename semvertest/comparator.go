@@ -0,0 +1,103 @@
+package semvertest
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+// CheckComparator verifies that cmp is a total order consistent with semver.Version.Equals: it must be reflexive,
+// antisymmetric and transitive and return 0 exactly for equal versions.
+// The properties are checked over the valid versions of the corpus and generated versions, the first violation of
+// each property is reported with t.Errorf.
+func CheckComparator(t testing.TB, cmp func(a, b semver.Version) int) {
+	t.Helper()
+
+	versions := comparatorVersions()
+
+	checkProperty(t, "reflexivity", func() string {
+		for _, a := range versions {
+			if cmp(a, a) != 0 {
+				return fmt.Sprintf("cmp(%s, %s) != 0", &a, &a)
+			}
+		}
+		return ""
+	})
+
+	checkProperty(t, "antisymmetry", func() string {
+		for _, a := range versions {
+			for _, b := range versions {
+				if sign(cmp(a, b)) != -sign(cmp(b, a)) {
+					return fmt.Sprintf("cmp(%s, %s) is not the inverse of cmp(%[2]s, %[1]s)", &a, &b)
+				}
+			}
+		}
+		return ""
+	})
+
+	checkProperty(t, "consistency with Equals", func() string {
+		for _, a := range versions {
+			for _, b := range versions {
+				if (cmp(a, b) == 0) != a.Equals(&b) {
+					return fmt.Sprintf("cmp(%s, %s) == 0 does not match Equals", &a, &b)
+				}
+			}
+		}
+		return ""
+	})
+
+	checkProperty(t, "transitivity", func() string {
+		for _, a := range versions {
+			for _, b := range versions {
+				if cmp(a, b) > 0 {
+					continue
+				}
+				for _, c := range versions {
+					if cmp(b, c) <= 0 && cmp(a, c) > 0 {
+						return fmt.Sprintf("%s <= %s <= %s but cmp(%[1]s, %[3]s) > 0", &a, &b, &c)
+					}
+				}
+			}
+		}
+		return ""
+	})
+}
+
+func checkProperty(t testing.TB, name string, check func() string) {
+	t.Helper()
+	if violation := check(); violation != "" {
+		t.Errorf("Comparator violates %s: %s", name, violation)
+	}
+}
+
+// comparatorVersions returns the valid versions of the corpus and generated versions with small components to
+// get versions that differ only in some parts.
+func comparatorVersions() []semver.Version {
+	var versions []semver.Version
+	for _, s := range Corpus().Valid() {
+		v, err := semver.ParseVersionWithOptions(s, semver.ParseOptions{})
+		if err != nil {
+			continue
+		}
+		versions = append(versions, *v)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		versions = append(versions, *Generate(r, Options{MaxComponent: 2, PreRelease: true, Build: true}))
+	}
+	return versions
+}
+
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,76 @@
+package semvertest_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/semvertest"
+)
+
+func TestCheckComparator(t *testing.T) {
+	tests := []struct {
+		name        string
+		cmp         func(a, b semver.Version) int
+		expectedErr string
+	}{
+		{
+			name: "precedence",
+			cmp: func(a, b semver.Version) int {
+				switch {
+				case a.Before(&b):
+					return -1
+				case b.Before(&a):
+					return 1
+				default:
+					return 0
+				}
+			},
+		},
+		{
+			name: "string order",
+			cmp: func(a, b semver.Version) int {
+				return strings.Compare(a.String(), b.String())
+			},
+			expectedErr: "Comparator violates consistency with Equals",
+		},
+		{
+			name: "not antisymmetric",
+			cmp: func(a, b semver.Version) int {
+				if a.Equals(&b) {
+					return 0
+				}
+				return -1
+			},
+			expectedErr: "Comparator violates antisymmetry",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rec := &recordingT{TB: t}
+			semvertest.CheckComparator(rec, test.cmp)
+
+			if test.expectedErr == "" {
+				if len(rec.errors) > 0 {
+					t.Errorf("Unexpected errors: %v", rec.errors)
+				}
+				return
+			}
+			if !strings.HasPrefix(fmt.Sprint(rec.errors), "["+test.expectedErr) {
+				t.Errorf("Expected error %q, got %v", test.expectedErr, rec.errors)
+			}
+		})
+	}
+}
+
+// recordingT records errors instead of failing the test.
+type recordingT struct {
+	testing.TB
+	errors []string
+}
+
+func (t *recordingT) Errorf(format string, args ...any) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,41 @@
+package semvertest
+
+import (
+	"embed"
+	"strings"
+)
+
+//go:embed corpus/*.txt
+var corpusFiles embed.FS
+
+// VersionCorpus is the canonical test corpus of valid and invalid versions from semver.org.
+type VersionCorpus struct {
+	valid   []string
+	invalid []string
+}
+
+// Corpus returns the bundled corpus.
+func Corpus() VersionCorpus {
+	return VersionCorpus{
+		valid:   readCorpusFile("corpus/valid.txt"),
+		invalid: readCorpusFile("corpus/invalid.txt"),
+	}
+}
+
+// Valid returns the versions that are valid according to the SemVer 2.0 spec.
+func (c VersionCorpus) Valid() []string {
+	return append([]string(nil), c.valid...)
+}
+
+// Invalid returns the strings that are not valid versions according to the SemVer 2.0 spec.
+func (c VersionCorpus) Invalid() []string {
+	return append([]string(nil), c.invalid...)
+}
+
+func readCorpusFile(name string) []string {
+	data, err := corpusFiles.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return strings.Split(strings.TrimSpace(string(data)), "\n")
+}
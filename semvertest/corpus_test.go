@@ -0,0 +1,46 @@
+package semvertest_test
+
+import (
+	"testing"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/semvertest"
+)
+
+// knownDeviations are entries of the corpus where ParseVersion deviates from the spec.
+var knownDeviations = map[string]string{
+	"99999999999999999999999.999999999999999999.99999999999999999": "numbers are limited to the size of int",
+	"1.2.3-0123":          "leading zeros of numeric pre-release identifiers are accepted",
+	"1.2.3-0123.0123":     "leading zeros of numeric pre-release identifiers are accepted",
+	"1.0.0-alpha..1":      "empty pre-release identifiers are accepted",
+	"1.0.0-alpha...1":     "empty pre-release identifiers are accepted",
+	"1.0.0-alpha....1":    "empty pre-release identifiers are accepted",
+	"1.0.0-alpha.....1":   "empty pre-release identifiers are accepted",
+	"1.0.0-alpha......1":  "empty pre-release identifiers are accepted",
+	"1.0.0-alpha.......1": "empty pre-release identifiers are accepted",
+}
+
+func TestCorpus(t *testing.T) {
+	corpus := semvertest.Corpus()
+
+	if len(corpus.Valid()) == 0 || len(corpus.Invalid()) == 0 {
+		t.Fatalf("Expected corpus to contain valid and invalid versions")
+	}
+
+	for _, s := range corpus.Valid() {
+		if _, deviates := knownDeviations[s]; deviates {
+			continue
+		}
+		if _, err := semver.ParseVersion(s); err != nil {
+			t.Errorf("Expected %q to be valid, got error: %v", s, err)
+		}
+	}
+	for _, s := range corpus.Invalid() {
+		if _, deviates := knownDeviations[s]; deviates {
+			continue
+		}
+		if _, err := semver.ParseVersion(s); err == nil {
+			t.Errorf("Expected %q to be invalid", s)
+		}
+	}
+}
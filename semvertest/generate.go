@@ -0,0 +1,93 @@
+// Package semvertest provides helpers to test code handling semantic versions.
+package semvertest
+
+import (
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/networkteam/semver"
+)
+
+// DefaultMaxComponent is the default upper bound of the major, minor and patch version of generated versions.
+const DefaultMaxComponent = 20
+
+// Options configure the generated versions.
+type Options struct {
+	// MaxComponent is the upper bound of the major, minor and patch version, it defaults to DefaultMaxComponent.
+	MaxComponent int
+	// PreRelease enables generating versions with a pre-release.
+	PreRelease bool
+	// Build enables generating versions with build metadata.
+	Build bool
+}
+
+const (
+	identifierChars = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ-"
+	nonDigitChars   = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ-"
+)
+
+// Generate returns a random valid version.
+// If pre-releases or build metadata are enabled, about half of the generated versions have them.
+func Generate(r *rand.Rand, opts Options) *semver.Version {
+	maxComponent := opts.MaxComponent
+	if maxComponent <= 0 {
+		maxComponent = DefaultMaxComponent
+	}
+
+	v := &semver.Version{
+		Major: r.Intn(maxComponent + 1),
+		Minor: r.Intn(maxComponent + 1),
+		Patch: r.Intn(maxComponent + 1),
+	}
+	if opts.PreRelease && r.Intn(2) == 0 {
+		v.PreRelease = identifiers(r, func() string {
+			if r.Intn(2) == 0 {
+				return strconv.Itoa(r.Intn(maxComponent + 1))
+			}
+			return alphanumericIdentifier(r)
+		})
+	}
+	if opts.Build && r.Intn(2) == 0 {
+		v.Build = identifiers(r, func() string {
+			return randomString(r, identifierChars, 1+r.Intn(8))
+		})
+	}
+	return v
+}
+
+// Version is a semver.Version implementing quick.Generator for use with testing/quick.
+// Generated versions can have a pre-release and build metadata, the size bounds the components.
+type Version struct {
+	*semver.Version
+}
+
+// Generate implements quick.Generator.
+func (Version) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(Version{Version: Generate(r, Options{MaxComponent: size, PreRelease: true, Build: true})})
+}
+
+func identifiers(r *rand.Rand, identifier func() string) string {
+	n := 1 + r.Intn(3)
+	parts := make([]string, n)
+	for i := range parts {
+		parts[i] = identifier()
+	}
+	return strings.Join(parts, ".")
+}
+
+// alphanumericIdentifier returns an identifier with at least one non-digit.
+func alphanumericIdentifier(r *rand.Rand) string {
+	s := []byte(randomString(r, identifierChars, 1+r.Intn(8)))
+	s[r.Intn(len(s))] = nonDigitChars[r.Intn(len(nonDigitChars))]
+	return string(s)
+}
+
+func randomString(r *rand.Rand, chars string, n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = chars[r.Intn(len(chars))]
+	}
+	return string(b)
+}
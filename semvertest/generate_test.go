@@ -0,0 +1,53 @@
+package semvertest_test
+
+import (
+	"math/rand"
+	"testing"
+	"testing/quick"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/semvertest"
+)
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name string
+		opts semvertest.Options
+	}{
+		{"release", semvertest.Options{MaxComponent: 3}},
+		{"pre-release", semvertest.Options{PreRelease: true}},
+		{"build", semvertest.Options{PreRelease: true, Build: true}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(1))
+			for i := 0; i < 1000; i++ {
+				v := semvertest.Generate(r, test.opts)
+
+				if _, err := semver.ParseVersion(v.String()); err != nil {
+					t.Fatalf("Expected valid version, got %q: %v", v, err)
+				}
+				if test.opts.MaxComponent > 0 && (v.Major > test.opts.MaxComponent || v.Minor > test.opts.MaxComponent || v.Patch > test.opts.MaxComponent) {
+					t.Errorf("Expected components to be at most %d, got %s", test.opts.MaxComponent, v)
+				}
+				if !test.opts.PreRelease && v.PreRelease != "" {
+					t.Errorf("Expected no pre-release, got %s", v)
+				}
+				if !test.opts.Build && v.Build != "" {
+					t.Errorf("Expected no build metadata, got %s", v)
+				}
+			}
+		})
+	}
+}
+
+func TestVersion_Generate(t *testing.T) {
+	roundTrip := func(v semvertest.Version) bool {
+		parsed, err := semver.ParseVersion(v.String())
+		return err == nil && parsed.Equals(v.Version) && parsed.Build == v.Build
+	}
+	if err := quick.Check(roundTrip, nil); err != nil {
+		t.Error(err)
+	}
+}
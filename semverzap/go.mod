@@ -0,0 +1,10 @@
+module github.com/networkteam/semver/semverzap
+
+go 1.21.5
+
+require (
+	github.com/networkteam/semver v1.1.0
+	go.uber.org/zap v1.27.0
+)
+
+require go.uber.org/multierr v1.10.0 // indirect
@@ -0,0 +1,42 @@
+// Package semverzap logs semantic versions with zap without fmt-based stringification.
+package semverzap
+
+import (
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/networkteam/semver"
+)
+
+// Version returns a field that logs the fields of the version as an object under the given key.
+//
+//	logger.Info("Started", semverzap.Version("version", v))
+func Version(key string, v *semver.Version) zap.Field {
+	return zap.Object(key, Marshaler(v))
+}
+
+// Marshaler returns a zapcore.ObjectMarshaler that logs the fields of the version.
+// A zero epoch, pre-release and build metadata are omitted.
+func Marshaler(v *semver.Version) zapcore.ObjectMarshaler {
+	return marshaler{v: v}
+}
+
+type marshaler struct {
+	v *semver.Version
+}
+
+func (m marshaler) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if m.v.Epoch != 0 {
+		enc.AddInt("epoch", m.v.Epoch)
+	}
+	enc.AddInt("major", m.v.Major)
+	enc.AddInt("minor", m.v.Minor)
+	enc.AddInt("patch", m.v.Patch)
+	if m.v.PreRelease != "" {
+		enc.AddString("prerelease", m.v.PreRelease)
+	}
+	if m.v.Build != "" {
+		enc.AddString("build", m.v.Build)
+	}
+	return nil
+}
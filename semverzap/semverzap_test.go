@@ -0,0 +1,47 @@
+package semverzap_test
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/semverzap"
+)
+
+func TestVersion(t *testing.T) {
+	v, err := semver.ParseVersion("1.2.3-rc.1+linux")
+	if err != nil {
+		t.Fatalf("Error parsing version: %v", err)
+	}
+
+	core, logs := observer.New(zap.InfoLevel)
+	zap.New(core).Info("test", semverzap.Version("version", v))
+
+	fields := logs.All()[0].ContextMap()["version"].(map[string]any)
+	expected := map[string]any{"major": 1, "minor": 2, "patch": 3, "prerelease": "rc.1", "build": "linux"}
+	for key, value := range expected {
+		if fields[key] != value {
+			t.Errorf("Expected field %s to be %v, got %v", key, value, fields[key])
+		}
+	}
+	if len(fields) != len(expected) {
+		t.Errorf("Expected %d fields, got %v", len(expected), fields)
+	}
+}
+
+func TestVersion_Epoch(t *testing.T) {
+	v, err := semver.ParseVersionWithOptions("2:1.2.3", semver.ParseOptions{Epoch: true})
+	if err != nil {
+		t.Fatalf("Error parsing version: %v", err)
+	}
+
+	core, logs := observer.New(zap.InfoLevel)
+	zap.New(core).Info("test", semverzap.Version("version", v))
+
+	fields := logs.All()[0].ContextMap()["version"].(map[string]any)
+	if fields["epoch"] != 2 || len(fields) != 4 {
+		t.Errorf("Expected epoch 2 and 4 fields, got %v", fields)
+	}
+}
@@ -0,0 +1,14 @@
+module github.com/networkteam/semver/semverzerolog
+
+go 1.21.5
+
+require (
+	github.com/networkteam/semver v1.1.0
+	github.com/rs/zerolog v1.34.0
+)
+
+require (
+	github.com/mattn/go-colorable v0.1.13 // indirect
+	github.com/mattn/go-isatty v0.0.19 // indirect
+	golang.org/x/sys v0.29.0 // indirect
+)
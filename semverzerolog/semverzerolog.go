@@ -0,0 +1,35 @@
+// Package semverzerolog logs semantic versions with zerolog without fmt-based stringification.
+package semverzerolog
+
+import (
+	"github.com/rs/zerolog"
+
+	"github.com/networkteam/semver"
+)
+
+// Marshaler returns a zerolog.LogObjectMarshaler that logs the fields of the version.
+// A zero epoch, pre-release and build metadata are omitted.
+//
+//	logger.Info().Object("version", semverzerolog.Marshaler(v)).Msg("Started")
+func Marshaler(v *semver.Version) zerolog.LogObjectMarshaler {
+	return marshaler{v: v}
+}
+
+type marshaler struct {
+	v *semver.Version
+}
+
+func (m marshaler) MarshalZerologObject(e *zerolog.Event) {
+	if m.v.Epoch != 0 {
+		e.Int("epoch", m.v.Epoch)
+	}
+	e.Int("major", m.v.Major).
+		Int("minor", m.v.Minor).
+		Int("patch", m.v.Patch)
+	if m.v.PreRelease != "" {
+		e.Str("prerelease", m.v.PreRelease)
+	}
+	if m.v.Build != "" {
+		e.Str("build", m.v.Build)
+	}
+}
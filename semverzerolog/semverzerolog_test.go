@@ -0,0 +1,39 @@
+package semverzerolog_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/semverzerolog"
+)
+
+func TestMarshaler(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected string
+	}{
+		{"1.2.3", `{"level":"info","version":{"major":1,"minor":2,"patch":3},"message":"test"}` + "\n"},
+		{"2:1.2.3", `{"level":"info","version":{"epoch":2,"major":1,"minor":2,"patch":3},"message":"test"}` + "\n"},
+		{"1.2.3-rc.1+linux", `{"level":"info","version":{"major":1,"minor":2,"patch":3,"prerelease":"rc.1","build":"linux"},"message":"test"}` + "\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			v, err := semver.ParseVersionWithOptions(test.version, semver.ParseOptions{Epoch: true})
+			if err != nil {
+				t.Fatalf("Error parsing version: %v", err)
+			}
+
+			var buf bytes.Buffer
+			logger := zerolog.New(&buf)
+			logger.Info().Object("version", semverzerolog.Marshaler(v)).Msg("test")
+
+			if buf.String() != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, buf.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package semver
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// snapshotMagic starts the binary snapshot of an Index or VersionList, followed by the format version.
+const snapshotMagic = "SVS"
+
+// snapshotFormat is the version of the snapshot format written by MarshalBinary.
+const snapshotFormat = 1
+
+// MarshalBinary implements encoding.BinaryMarshaler and returns a snapshot of the versions of the index.
+func (idx *Index) MarshalBinary() ([]byte, error) {
+	versions := make([]*Version, 0, idx.Len())
+	idx.Ascend(func(v *Version) bool {
+		versions = append(versions, v)
+		return true
+	})
+	return marshalSnapshot(versions), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler and replaces the versions of the index with the versions of
+// a snapshot returned by MarshalBinary.
+func (idx *Index) UnmarshalBinary(data []byte) error {
+	versions, err := unmarshalSnapshot(data)
+	if err != nil {
+		return err
+	}
+	*idx = Index{}
+	for _, v := range versions {
+		idx.Insert(v)
+	}
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler and returns a snapshot of the versions of the list.
+func (l *VersionList) MarshalBinary() ([]byte, error) {
+	return marshalSnapshot(l.Snapshot()), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler and replaces the versions of the list with the versions of
+// a snapshot returned by MarshalBinary. Subscribers are not notified.
+func (l *VersionList) UnmarshalBinary(data []byte) error {
+	versions, err := unmarshalSnapshot(data)
+	if err != nil {
+		return err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.versions.Store(&versions)
+	return nil
+}
+
+// marshalSnapshot encodes the sorted versions into a single buffer: the magic and format version, the number of
+// versions and for each version the epoch, major, minor and patch as uvarints and the length-prefixed pre-release
+// and build.
+func marshalSnapshot(versions []*Version) []byte {
+	size := len(snapshotMagic) + 1 + binary.MaxVarintLen64
+	for _, v := range versions {
+		size += 6*binary.MaxVarintLen64 + len(v.PreRelease) + len(v.Build)
+	}
+
+	buf := make([]byte, 0, size)
+	buf = append(buf, snapshotMagic...)
+	buf = append(buf, snapshotFormat)
+	buf = binary.AppendUvarint(buf, uint64(len(versions)))
+	for _, v := range versions {
+		buf = binary.AppendUvarint(buf, uint64(v.Epoch))
+		buf = binary.AppendUvarint(buf, uint64(v.Major))
+		buf = binary.AppendUvarint(buf, uint64(v.Minor))
+		buf = binary.AppendUvarint(buf, uint64(v.Patch))
+		buf = binary.AppendUvarint(buf, uint64(len(v.PreRelease)))
+		buf = append(buf, v.PreRelease...)
+		buf = binary.AppendUvarint(buf, uint64(len(v.Build)))
+		buf = append(buf, v.Build...)
+	}
+	return buf
+}
+
+// unmarshalSnapshot decodes and validates the versions of a snapshot. They must be in ascending order of precedence,
+// versions with equal precedence (e.g. of a VersionList in insertion order) must have distinct build metadata.
+func unmarshalSnapshot(data []byte) ([]*Version, error) {
+	if len(data) < len(snapshotMagic)+1 || string(data[:len(snapshotMagic)]) != snapshotMagic {
+		return nil, errors.New("invalid snapshot: missing header")
+	}
+	if format := data[len(snapshotMagic)]; format != snapshotFormat {
+		return nil, fmt.Errorf("unsupported snapshot format %d", format)
+	}
+	d := snapshotDecoder{data: data[len(snapshotMagic)+1:]}
+
+	count := d.uvarint()
+	if d.err == nil && count > uint64(len(d.data)) {
+		return nil, errors.New("invalid snapshot: version count exceeds data")
+	}
+	versions := make([]*Version, 0, count)
+	// builds are the build metadata of the decoded versions with the precedence of the last version.
+	builds := map[string]bool{}
+	for i := uint64(0); i < count && d.err == nil; i++ {
+		v := &Version{
+			Epoch: d.int(),
+			Major: d.int(),
+			Minor: d.int(),
+			Patch: d.int(),
+		}
+		v.PreRelease = d.string()
+		v.Build = d.string()
+		if d.err != nil {
+			break
+		}
+		if err := parseIdentifiers(v.PreRelease, true); err != nil {
+			return nil, fmt.Errorf("invalid snapshot: version %d: pre-release: %w", i, err)
+		}
+		if err := parseIdentifiers(v.Build, false); err != nil {
+			return nil, fmt.Errorf("invalid snapshot: version %d: build: %w", i, err)
+		}
+		if len(versions) > 0 {
+			switch compareVersions(versions[len(versions)-1], v) {
+			case 1:
+				return nil, fmt.Errorf("invalid snapshot: version %d is out of order", i)
+			case -1:
+				clear(builds)
+			}
+		}
+		if builds[v.Build] {
+			return nil, fmt.Errorf("invalid snapshot: version %d is a duplicate", i)
+		}
+		builds[v.Build] = true
+		versions = append(versions, v)
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	if len(d.data) > 0 {
+		return nil, errors.New("invalid snapshot: trailing data")
+	}
+	return versions, nil
+}
+
+// parseIdentifiers checks a pre-release or build with the rules of the parser, so every parsed version can be
+// restored from a snapshot.
+func parseIdentifiers(s string, preRelease bool) error {
+	if s == "" {
+		return nil
+	}
+	p := &Parser{input: s}
+	var err error
+	if preRelease {
+		_, err = p.parsePreRelease()
+	} else {
+		_, err = p.parseBuild()
+	}
+	if err == nil && p.pos < len(p.input) {
+		err = newParseError(p.pos, KindTrailingCharacters, p.input[p.pos:])
+	}
+	return err
+}
+
+// snapshotDecoder reads values from data and records the first error.
+type snapshotDecoder struct {
+	data []byte
+	err  error
+}
+
+func (d *snapshotDecoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	n, size := binary.Uvarint(d.data)
+	if size <= 0 {
+		d.err = errors.New("invalid snapshot: truncated or malformed number")
+		return 0
+	}
+	d.data = d.data[size:]
+	return n
+}
+
+func (d *snapshotDecoder) int() int {
+	n := d.uvarint()
+	if d.err == nil && n > math.MaxInt {
+		d.err = errors.New("invalid snapshot: number out of range")
+	}
+	return int(n)
+}
+
+func (d *snapshotDecoder) string() string {
+	n := d.uvarint()
+	if d.err != nil {
+		return ""
+	}
+	if n > uint64(len(d.data)) {
+		d.err = errors.New("invalid snapshot: truncated string")
+		return ""
+	}
+	s := string(d.data[:n])
+	d.data = d.data[n:]
+	return s
+}
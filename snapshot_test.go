@@ -0,0 +1,114 @@
+package semver_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestIndex_Snapshot(t *testing.T) {
+	idx := semver.NewIndex()
+	for i := 0; i < 5000; i++ {
+		idx.Insert(&semver.Version{Major: i / 100, Minor: i % 100, PreRelease: []string{"", "rc.1"}[i%2], Build: []string{"", "linux"}[i%3%2]})
+	}
+
+	data, err := idx.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	restored := &semver.Index{}
+	if err := restored.UnmarshalBinary(data); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var expected, got []*semver.Version
+	idx.Ascend(func(v *semver.Version) bool { expected = append(expected, v); return true })
+	restored.Ascend(func(v *semver.Version) bool { got = append(got, v); return true })
+	if restored.Len() != idx.Len() || fmt.Sprint(versionStrings(got)) != fmt.Sprint(versionStrings(expected)) {
+		t.Errorf("Expected restored index to equal the original")
+	}
+}
+
+func TestVersionList_Snapshot(t *testing.T) {
+	l := semver.NewVersionList(mustParse(t, "1.0.0"), mustParse(t, "1.0.0+linux"), mustParse(t, "0.9.0-rc.1"))
+	data, err := l.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var restored semver.VersionList
+	if err := restored.UnmarshalBinary(data); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := fmt.Sprint(versionStrings(restored.Snapshot())); got != "[0.9.0-rc.1 1.0.0 1.0.0+linux]" {
+		t.Errorf("Unexpected versions %s", got)
+	}
+}
+
+func TestSnapshot_RoundTrip(t *testing.T) {
+	// Builds with equal precedence stay in insertion order in a VersionList, and the parser accepts numeric
+	// identifiers with leading zeros and empty identifiers after the first.
+	inputs := []string{"1.0.0+b", "1.0.0+a", "1.2.3-0123", "1.0.0-alpha..1", "1.0.0+a..b"}
+	var versions []*semver.Version
+	for _, s := range inputs {
+		versions = append(versions, mustParse(t, s))
+	}
+
+	l := semver.NewVersionList(versions...)
+	data, err := l.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var restoredList semver.VersionList
+	if err := restoredList.UnmarshalBinary(data); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got, expected := fmt.Sprint(versionStrings(restoredList.Snapshot())), fmt.Sprint(versionStrings(l.Snapshot())); got != expected {
+		t.Errorf("Expected %s, got %s", expected, got)
+	}
+
+	idx := semver.NewIndex(versions...)
+	data, err = idx.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var restoredIndex semver.Index
+	if err := restoredIndex.UnmarshalBinary(data); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if restoredIndex.Len() != len(inputs) {
+		t.Errorf("Expected %d versions, got %d", len(inputs), restoredIndex.Len())
+	}
+}
+
+func TestUnmarshalBinary_Invalid(t *testing.T) {
+	valid, err := semver.NewVersionList(mustParse(t, "1.0.0-rc.1"), mustParse(t, "2.0.0")).MarshalBinary()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		data        []byte
+		expectedErr string
+	}{
+		{"empty", nil, "invalid snapshot: missing header"},
+		{"format", append([]byte("SVS"), 2), "unsupported snapshot format 2"},
+		{"truncated", valid[:len(valid)-3], "invalid snapshot: truncated or malformed number"},
+		{"trailing data", append(valid, 0), "invalid snapshot: trailing data"},
+		{"invalid pre-release", []byte("SVS\x01\x01\x00\x01\x00\x00\x02r_\x00"), `invalid snapshot: version 0: pre-release: unexpected trailing characters: "_" (at position 1)`},
+		{"out of order", []byte("SVS\x01\x02\x00\x02\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00"), "invalid snapshot: version 1 is out of order"},
+		{"duplicate", []byte("SVS\x01\x03\x00\x01\x00\x00\x00\x01a\x00\x01\x00\x00\x00\x00\x00\x01\x00\x00\x00\x01a"), "invalid snapshot: version 2 is a duplicate"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var idx semver.Index
+			err := idx.UnmarshalBinary(test.data)
+			if err == nil || err.Error() != test.expectedErr {
+				t.Errorf("Expected error %q, got %v", test.expectedErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package semver
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// SortStringsLoose sorts the strings in place: valid versions first in ascending order of precedence, followed by
+// all invalid versions in natural order (see CompareNatural). Valid versions with equal precedence are ordered
+// naturally as well.
+func SortStringsLoose(s []string) {
+	_ = SortStringsLooseContext(context.Background(), s)
+}
+
+// SortStringsLooseContext sorts the strings like SortStringsLoose, but returns the error of the context if it is done
+// while parsing or sorting the versions. The strings are not modified in that case.
+func SortStringsLooseContext(ctx context.Context, s []string) error {
+	versions := make([]*Version, len(s))
+	for i, str := range s {
+		if err := checkContext(ctx, i); err != nil {
+			return err
+		}
+		versions[i], _ = ParseVersionWithOptions(str, ParseOptions{})
+	}
+
+	// Sort a copy, so the strings are only modified if the sort was not cancelled.
+	l := &looseStrings{ctx: ctx, strings: slices.Clone(s), versions: versions}
+	sort.Sort(l)
+	if l.err != nil {
+		return l.err
+	}
+	copy(s, l.strings)
+	return nil
+}
+
+type looseStrings struct {
+	ctx      context.Context
+	strings  []string
+	versions []*Version
+
+	// comparisons counts the calls of Less to check the context periodically, err is the error of the context.
+	comparisons int
+	err         error
+}
+
+func (l *looseStrings) Len() int {
+	return len(l.strings)
+}
+
+func (l *looseStrings) Less(i, j int) bool {
+	if l.err != nil {
+		// Finish the cancelled sort quickly, its result is discarded.
+		return false
+	}
+	if l.err = checkContext(l.ctx, l.comparisons); l.err != nil {
+		return false
+	}
+	l.comparisons++
+
+	a, b := l.versions[i], l.versions[j]
+	switch {
+	case a != nil && b != nil:
+		if result := compareVersions(a, b); result != 0 {
+			return result < 0
+		}
+	case a != nil:
+		return true
+	case b != nil:
+		return false
+	}
+	return CompareNatural(l.strings[i], l.strings[j]) < 0
+}
+
+func (l *looseStrings) Swap(i, j int) {
+	l.strings[i], l.strings[j] = l.strings[j], l.strings[i]
+	l.versions[i], l.versions[j] = l.versions[j], l.versions[i]
+}
+
+// CompareNatural compares two arbitrary strings in natural order and returns -1, 0 or 1.
+// Runs of digits are compared numerically and all other characters byte-wise, similar to strverscmp,
+// so "release-9" sorts before "release-10".
+func CompareNatural(a, b string) int {
+	for a != "" && b != "" {
+		var chunkA, chunkB string
+		chunkA, a = nextChunk(a)
+		chunkB, b = nextChunk(b)
+
+		if isDigit(chunkA[0]) && isDigit(chunkB[0]) {
+			if result := compareNumericStrings(chunkA, chunkB); result != 0 {
+				return result
+			}
+		}
+		if chunkA < chunkB {
+			return -1
+		} else if chunkA > chunkB {
+			return 1
+		}
+	}
+	return compareInts(len(a), len(b))
+}
+
+// CompareLoose compares two strings that are expected to be versions and returns -1, 0 or 1, in the order of
+// SortStringsLoose: valid versions are ordered before invalid ones and compared by precedence, invalid versions (and
+// valid versions of equal precedence) are compared with CompareNatural. This is useful to order heterogeneous tag
+// lists.
+func CompareLoose(a, b string) int {
+	va, errA := ParseVersionWithOptions(a, ParseOptions{})
+	vb, errB := ParseVersionWithOptions(b, ParseOptions{})
+	switch {
+	case errA == nil && errB == nil:
+		if result := compareVersions(va, vb); result != 0 {
+			return result
+		}
+	case errA == nil:
+		return -1
+	case errB == nil:
+		return 1
+	}
+	return CompareNatural(a, b)
+}
+
+// nextChunk splits s into a leading run of digits or non-digits and the rest.
+func nextChunk(s string) (chunk, rest string) {
+	digit := isDigit(s[0])
+	i := 1
+	for i < len(s) && isDigit(s[i]) == digit {
+		i++
+	}
+	return s[:i], s[i:]
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// compareNumericStrings compares two strings of digits of arbitrary length by their numeric value.
+func compareNumericStrings(a, b string) int {
+	a = strings.TrimLeft(a, "0")
+	b = strings.TrimLeft(b, "0")
+	if len(a) != len(b) {
+		return compareInts(len(a), len(b))
+	}
+	return strings.Compare(a, b)
+}
@@ -0,0 +1,93 @@
+package semver_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestSortStringsLoose(t *testing.T) {
+	s := []string{"latest", "1.10.0", "v1.2.0", "1.2.0", "1.0.0+b", "1.0.0-rc.1", "", "1.0.0+a", "1.9.0", "main"}
+
+	semver.SortStringsLoose(s)
+
+	expected := "[1.0.0-rc.1 1.0.0+a 1.0.0+b 1.2.0 1.9.0 1.10.0  latest main v1.2.0]"
+	if fmt.Sprint(s) != expected {
+		t.Errorf("Expected %s, got %s", expected, s)
+	}
+}
+
+func TestCompareNatural(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"release-9", "release-10", -1},
+		{"release-10", "release-9", 1},
+		{"release-10", "release-10", 0},
+		{"1.2.10", "1.2.9", 1},
+		{"1.2", "1.2.1", -1},
+		{"1.2a", "1.2b", -1},
+		{"v007", "v7", -1}, // Leading zeros sort first
+		{"r2023-01-02", "r2023-1-3", -1},
+		{"abc", "abd", -1},
+		{"", "a", -1},
+		{"99999999999999999999", "100000000000000000000", -1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.a+" <=> "+test.b, func(t *testing.T) {
+			if result := semver.CompareNatural(test.a, test.b); result != test.expected {
+				t.Errorf("Expected CompareNatural(%q, %q) to be %d, got %d", test.a, test.b, test.expected, result)
+			}
+		})
+	}
+}
+
+func TestCompareLoose(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"1.0.0-rc.1", "1.0.0", -1},            // SemVer precedence
+		{"1.0.0-alpha.10", "1.0.0-alpha.9", 1}, // Numeric identifiers
+		{"1.0.0-rc.1", "1.0.0-rc1", -1},        // Natural order as fallback
+		{"v1.10.0", "v1.9.0", 1},
+		{"10.0.0", "9.x", -1}, // Valid versions first
+		{"latest", "1.0.0", 1},
+		{"1.0.0+b", "1.0.0+a", 1},
+		{"1.0.0", "1.0.0", 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.a+" <=> "+test.b, func(t *testing.T) {
+			if result := semver.CompareLoose(test.a, test.b); result != test.expected {
+				t.Errorf("Expected CompareLoose(%q, %q) to be %d, got %d", test.a, test.b, test.expected, result)
+			}
+		})
+	}
+}
+
+func TestCompareLoose_Transitive(t *testing.T) {
+	// Comparing valid and invalid versions naturally ordered 1.0.0 < 1.0.0- < 1.0.0-rc.1 < 1.0.0.
+	s := []string{"9.0.0", "1.0.0-rc.1", "10.0.0-x", "1.0.0-", "1.0.0-alpha", "10-beta", "v1.2.3", "1.0.0", "release-10", "release-9"}
+
+	for _, a := range s {
+		for _, b := range s {
+			for _, c := range s {
+				if semver.CompareLoose(a, b) <= 0 && semver.CompareLoose(b, c) <= 0 && semver.CompareLoose(a, c) > 0 {
+					t.Errorf("Expected CompareLoose to be transitive for %q <= %q <= %q", a, b, c)
+				}
+			}
+		}
+	}
+
+	sorted := slices.Clone(s)
+	slices.SortFunc(sorted, semver.CompareLoose)
+	semver.SortStringsLoose(s)
+	if !slices.Equal(sorted, s) {
+		t.Errorf("Expected order of SortStringsLoose %q, got %q", s, sorted)
+	}
+}
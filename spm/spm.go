@@ -0,0 +1,98 @@
+// Package spm builds version requirements with the semantics of the Swift Package Manager, e.g. to validate the
+// requirements of a generated Package.swift.
+//
+// Like in SwiftPM, a range only matches pre-releases if one of its bounds is a pre-release, so
+// .upToNextMajor(from: "1.2.3") matches 1.9.0 but neither 1.9.0-beta.1 nor 2.0.0-beta.1. Even then, an upper bound
+// without pre-release excludes its own pre-releases, so "1.0.0-beta.1"..<"2.0.0" does not match 2.0.0-rc.1.
+package spm
+
+import (
+	"fmt"
+
+	"github.com/networkteam/semver"
+)
+
+type kind int
+
+const (
+	kindFrom kind = iota
+	kindUpToNextMinor
+	kindExact
+	kindRange
+)
+
+// Requirement is a version requirement of a package dependency. It implements semver.Matcher.
+type Requirement struct {
+	kind  kind
+	lower *semver.Version
+	upper *semver.Version
+}
+
+// From returns the requirement `from: "1.2.3"`, the same as UpToNextMajor.
+func From(v *semver.Version) Requirement {
+	return Requirement{kind: kindFrom, lower: v, upper: &semver.Version{Major: v.Major + 1}}
+}
+
+// UpToNextMajor returns the requirement `.upToNextMajor(from: "1.2.3")`, matching 1.2.3 up to but excluding 2.0.0.
+func UpToNextMajor(v *semver.Version) Requirement {
+	return From(v)
+}
+
+// UpToNextMinor returns the requirement `.upToNextMinor(from: "1.2.3")`, matching 1.2.3 up to but excluding 1.3.0.
+func UpToNextMinor(v *semver.Version) Requirement {
+	return Requirement{kind: kindUpToNextMinor, lower: v, upper: &semver.Version{Major: v.Major, Minor: v.Minor + 1}}
+}
+
+// Exact returns the requirement `exact: "1.2.3"`.
+func Exact(v *semver.Version) Requirement {
+	return Requirement{kind: kindExact, lower: v, upper: v}
+}
+
+// HalfOpen returns the requirement `"1.2.3"..<"2.0.0"`. It returns an error if lower is not before upper, like the
+// precondition of a Swift range.
+func HalfOpen(lower, upper *semver.Version) (Requirement, error) {
+	if lower.AtLeast(upper) {
+		return Requirement{}, fmt.Errorf("invalid range %q..<%q: lower bound must be before upper bound", lower, upper)
+	}
+	return Requirement{kind: kindRange, lower: lower, upper: upper}, nil
+}
+
+// Range returns the interval of the requirement by precedence. Unlike Match, it contains the pre-releases within the
+// bounds.
+func (r Requirement) Range() semver.Range {
+	if r.kind == kindExact {
+		return semver.Range{Lower: r.lower, LowerInclusive: true, Upper: r.upper, UpperInclusive: true}
+	}
+	return semver.Range{Lower: r.lower, LowerInclusive: true, Upper: r.upper}
+}
+
+// Match determines if the version satisfies the requirement like SwiftPM's Range<Version>.contains(version:).
+func (r Requirement) Match(v *semver.Version) bool {
+	if r.kind == kindExact {
+		return v.Equals(r.lower)
+	}
+	if v.PreRelease != "" {
+		if r.lower.PreRelease == "" && r.upper.PreRelease == "" {
+			return false
+		}
+		// Reject 2.0.0-alpha for the upper bound 2.0.0.
+		if r.upper.PreRelease == "" && v.Major == r.upper.Major && v.Minor == r.upper.Minor && v.Patch == r.upper.Patch {
+			return false
+		}
+	}
+	return r.Range().Match(v)
+}
+
+// String returns the requirement in the syntax of Package.swift.
+func (r Requirement) String() string {
+	switch r.kind {
+	case kindFrom:
+		return fmt.Sprintf("from: %q", r.lower)
+	case kindUpToNextMinor:
+		return fmt.Sprintf(".upToNextMinor(from: %q)", r.lower)
+	case kindExact:
+		return fmt.Sprintf("exact: %q", r.lower)
+	default:
+		return fmt.Sprintf("%q..<%q", r.lower, r.upper)
+	}
+}
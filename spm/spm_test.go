@@ -0,0 +1,68 @@
+package spm_test
+
+import (
+	"testing"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/spm"
+)
+
+func mustParse(t *testing.T, s string) *semver.Version {
+	t.Helper()
+	v, err := semver.ParseVersion(s)
+	if err != nil {
+		t.Fatalf("Error parsing version %q: %v", s, err)
+	}
+	return v
+}
+
+func TestRequirement(t *testing.T) {
+	halfOpen, err := spm.HalfOpen(mustParse(t, "1.0.0"), mustParse(t, "1.5.0"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	preReleaseUpper, err := spm.HalfOpen(mustParse(t, "1.0.0"), mustParse(t, "2.0.0-beta.2"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		requirement spm.Requirement
+		source      string
+		matching    []string
+		rejected    []string
+	}{
+		{spm.From(mustParse(t, "1.2.3")), `from: "1.2.3"`, []string{"1.2.3", "1.9.0"}, []string{"1.2.2", "1.9.0-beta.1", "2.0.0-beta.1", "2.0.0"}},
+		{spm.From(mustParse(t, "1.0.0-beta.1")), `from: "1.0.0-beta.1"`, []string{"1.0.0-beta.1", "1.0.0-beta.2", "1.5.0-rc.1", "1.9.0"}, []string{"1.0.0-alpha", "2.0.0-rc.1", "2.0.0"}},
+		{spm.UpToNextMajor(mustParse(t, "0.4.0")), `from: "0.4.0"`, []string{"0.9.0"}, []string{"1.0.0"}},
+		{spm.UpToNextMinor(mustParse(t, "1.2.3")), `.upToNextMinor(from: "1.2.3")`, []string{"1.2.3", "1.2.99"}, []string{"1.3.0-rc.1", "1.3.0"}},
+		{spm.Exact(mustParse(t, "1.2.3")), `exact: "1.2.3"`, []string{"1.2.3", "1.2.3+build"}, []string{"1.2.3-rc.1", "1.2.4"}},
+		{spm.Exact(mustParse(t, "1.2.3-rc.1")), `exact: "1.2.3-rc.1"`, []string{"1.2.3-rc.1"}, []string{"1.2.3-rc.2", "1.2.3"}},
+		{halfOpen, `"1.0.0"..<"1.5.0"`, []string{"1.4.9"}, []string{"1.4.9-rc.1", "1.5.0-alpha", "1.5.0"}},
+		{preReleaseUpper, `"1.0.0"..<"2.0.0-beta.2"`, []string{"1.4.9-rc.1", "2.0.0-beta.1"}, []string{"2.0.0-beta.2", "2.0.0"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			if test.requirement.String() != test.source {
+				t.Errorf("Expected %q, got %q", test.source, test.requirement)
+			}
+			for _, s := range test.matching {
+				if !test.requirement.Match(mustParse(t, s)) {
+					t.Errorf("Expected %s to match", s)
+				}
+			}
+			for _, s := range test.rejected {
+				if test.requirement.Match(mustParse(t, s)) {
+					t.Errorf("Expected %s not to match", s)
+				}
+			}
+		})
+	}
+}
+
+func TestHalfOpen_Invalid(t *testing.T) {
+	if _, err := spm.HalfOpen(mustParse(t, "2.0.0"), mustParse(t, "1.0.0")); err == nil {
+		t.Errorf("Expected error for empty range")
+	}
+}
@@ -0,0 +1,46 @@
+package semver
+
+import (
+	"fmt"
+)
+
+// ClientSupport is the range of client versions supported by a server.
+// A server publishes it, clients (or the server itself) call CheckClientSupported to validate a client version.
+type ClientSupport struct {
+	// MinClient is the optional minimum supported client version.
+	MinClient *Version
+	// MaxClient is the optional maximum supported client version.
+	MaxClient *Version
+}
+
+// ClientTooOldError is returned by CheckClientSupported if the client version is below MinClient.
+type ClientTooOldError struct {
+	Client    *Version
+	MinClient *Version
+}
+
+func (e *ClientTooOldError) Error() string {
+	return fmt.Sprintf("client version %s is not supported, please upgrade to %s or later", e.Client, e.MinClient)
+}
+
+// ClientTooNewError is returned by CheckClientSupported if the client version is above MaxClient.
+type ClientTooNewError struct {
+	Client    *Version
+	MaxClient *Version
+}
+
+func (e *ClientTooNewError) Error() string {
+	return fmt.Sprintf("client version %s is not supported yet, please downgrade to %s or earlier or wait for a server update", e.Client, e.MaxClient)
+}
+
+// CheckClientSupported returns a *ClientTooOldError or *ClientTooNewError if the client version is outside the
+// supported range, otherwise nil. Build metadata is ignored.
+func (s ClientSupport) CheckClientSupported(client *Version) error {
+	if s.MinClient != nil && client.Before(s.MinClient) {
+		return &ClientTooOldError{Client: client, MinClient: s.MinClient}
+	}
+	if s.MaxClient != nil && s.MaxClient.Before(client) {
+		return &ClientTooNewError{Client: client, MaxClient: s.MaxClient}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package semver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestClientSupport_CheckClientSupported(t *testing.T) {
+	support := semver.ClientSupport{
+		MinClient: mustParse(t, "1.2.0"),
+		MaxClient: mustParse(t, "2.5.0"),
+	}
+
+	tests := []struct {
+		client      string
+		expectedErr string
+	}{
+		{"1.2.0", ""},
+		{"2.5.0+build.7", ""},
+		{"1.2.0-rc.1", "client version 1.2.0-rc.1 is not supported, please upgrade to 1.2.0 or later"},
+		{"2.6.0", "client version 2.6.0 is not supported yet, please downgrade to 2.5.0 or earlier or wait for a server update"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.client, func(t *testing.T) {
+			err := support.CheckClientSupported(mustParse(t, test.client))
+			if test.expectedErr == "" {
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != test.expectedErr {
+				t.Errorf("Expected error %q, got %v", test.expectedErr, err)
+			}
+		})
+	}
+
+	t.Run("typed errors", func(t *testing.T) {
+		var tooOld *semver.ClientTooOldError
+		if err := support.CheckClientSupported(mustParse(t, "1.0.0")); !errors.As(err, &tooOld) || tooOld.MinClient.String() != "1.2.0" {
+			t.Errorf("Expected *ClientTooOldError, got %v", err)
+		}
+		var tooNew *semver.ClientTooNewError
+		if err := support.CheckClientSupported(mustParse(t, "3.0.0")); !errors.As(err, &tooNew) || tooNew.MaxClient.String() != "2.5.0" {
+			t.Errorf("Expected *ClientTooNewError, got %v", err)
+		}
+	})
+
+	t.Run("unbounded", func(t *testing.T) {
+		if err := (semver.ClientSupport{}).CheckClientSupported(mustParse(t, "0.0.1")); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+}
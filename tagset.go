@@ -0,0 +1,71 @@
+package semver
+
+import (
+	"strconv"
+	"strings"
+)
+
+// TagSetOptions contains the options for TagSet.
+type TagSetOptions struct {
+	// Published are the already published versions. A floating tag (e.g. "1.2", "1" or "latest") is only given to v if
+	// it has the highest precedence of the versions the tag covers, so publishing a patch of an old line does not move
+	// the tags of newer lines. If empty, v is considered the newest version.
+	Published []*Version
+	// Prefix is added to each tag except the latest and channel tags, e.g. "v".
+	Prefix string
+	// Suffix is added to each tag for variants of an image, e.g. "-alpine". The latest tag becomes the suffix without
+	// leading hyphen ("alpine").
+	Suffix string
+}
+
+// TagSet returns the conventional cascading container image tags of a version, like docker-library images:
+//
+//	1.2.3           1.2.3, 1.2, 1, latest
+//	1.3.0-rc.1      1.3.0-rc.1, rc
+//
+// The tags of a release are the full version, the minor and major line and latest. The tags of a pre-release are the
+// full version and the channel (the first pre-release identifier, if it is not numeric). Build metadata and the epoch
+// are omitted, since they are not allowed in tags.
+func TagSet(v *Version, opts TagSetOptions) []string {
+	core := strconv.Itoa(v.Major) + "." + strconv.Itoa(v.Minor) + "." + strconv.Itoa(v.Patch)
+	tag := func(name string) string { return opts.Prefix + name + opts.Suffix }
+
+	if v.PreRelease != "" {
+		tags := []string{tag(core + "-" + v.PreRelease)}
+		channel, _, _ := strings.Cut(v.PreRelease, ".")
+		if _, numeric := checkNumeric(channel); !numeric && newestOf(v, opts.Published, func(p *Version) bool {
+			other, _, _ := strings.Cut(p.PreRelease, ".")
+			return p.PreRelease != "" && other == channel
+		}) {
+			tags = append(tags, channel+opts.Suffix)
+		}
+		return tags
+	}
+
+	tags := []string{tag(core)}
+	stable := func(p *Version) bool { return p.PreRelease == "" && p.Epoch == v.Epoch }
+	if newestOf(v, opts.Published, func(p *Version) bool { return stable(p) && p.Major == v.Major && p.Minor == v.Minor }) {
+		tags = append(tags, tag(strconv.Itoa(v.Major)+"."+strconv.Itoa(v.Minor)))
+	}
+	if newestOf(v, opts.Published, func(p *Version) bool { return stable(p) && p.Major == v.Major }) {
+		tags = append(tags, tag(strconv.Itoa(v.Major)))
+	}
+	if newestOf(v, opts.Published, stable) {
+		if opts.Suffix != "" {
+			tags = append(tags, strings.TrimPrefix(opts.Suffix, "-"))
+		} else {
+			tags = append(tags, "latest")
+		}
+	}
+	return tags
+}
+
+// newestOf determines if no published version covered by the predicate has a higher precedence than v.
+func newestOf(v *Version, published []*Version, covered func(*Version) bool) bool {
+	for _, p := range published {
+		if covered(p) && compareVersions(p, v) > 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,39 @@
+package semver_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestTagSet(t *testing.T) {
+	var published []*semver.Version
+	for _, s := range []string{"1.2.3", "1.3.0", "2.0.0", "2.1.0-rc.1", "2.1.0-beta.3"} {
+		published = append(published, mustParse(t, s))
+	}
+
+	tests := []struct {
+		version  string
+		opts     semver.TagSetOptions
+		expected string
+	}{
+		{"1.2.3", semver.TagSetOptions{}, "[1.2.3 1.2 1 latest]"},
+		{"1.2.3+build.5", semver.TagSetOptions{Prefix: "v"}, "[v1.2.3 v1.2 v1 latest]"},
+		{"1.2.4", semver.TagSetOptions{Published: published}, "[1.2.4 1.2]"},
+		{"1.3.1", semver.TagSetOptions{Published: published}, "[1.3.1 1.3 1]"},
+		{"2.0.1", semver.TagSetOptions{Published: published, Suffix: "-alpine"}, "[2.0.1-alpine 2.0-alpine 2-alpine alpine]"},
+		{"2.1.0-rc.2", semver.TagSetOptions{Published: published}, "[2.1.0-rc.2 rc]"},
+		{"2.1.0-beta.2", semver.TagSetOptions{Published: published}, "[2.1.0-beta.2]"},
+		{"2.1.0-1", semver.TagSetOptions{}, "[2.1.0-1]"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			got := semver.TagSet(mustParse(t, test.version), test.opts)
+			if fmt.Sprint(got) != test.expected {
+				t.Errorf("Expected %s, got %v", test.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package semver
+
+import (
+	"sort"
+	"time"
+)
+
+// TimelineEntry is a version with its release time.
+type TimelineEntry struct {
+	Version  *Version
+	Released time.Time
+}
+
+// Timeline pairs versions with their release times for queries like the latest version as of a date.
+// The zero value is an empty timeline ready to use. A Timeline is not safe for concurrent use.
+type Timeline struct {
+	// entries are sorted by release time.
+	entries []TimelineEntry
+	eols    []lineEOL
+}
+
+// lineEOL is the end of life of the versions of a release line.
+type lineEOL struct {
+	line Matcher
+	eol  time.Time
+}
+
+// NewTimeline returns a timeline with the given entries.
+func NewTimeline(entries ...TimelineEntry) *Timeline {
+	t := &Timeline{}
+	for _, e := range entries {
+		t.Add(e.Version, e.Released)
+	}
+	return t
+}
+
+// Timeline returns the timeline of the dated releases of the changelog, yanked releases are skipped.
+func (c *Changelog) Timeline() *Timeline {
+	t := &Timeline{}
+	for _, r := range c.Releases {
+		if r.Date.IsZero() || r.Yanked {
+			continue
+		}
+		t.Add(r.Version, r.Date)
+	}
+	return t
+}
+
+// Add adds a version released at the given time.
+func (t *Timeline) Add(v *Version, released time.Time) {
+	i := sort.Search(len(t.entries), func(i int) bool { return t.entries[i].Released.After(released) })
+	t.entries = append(t.entries, TimelineEntry{})
+	copy(t.entries[i+1:], t.entries[i:])
+	t.entries[i] = TimelineEntry{Version: v, Released: released}
+}
+
+// Entries returns the entries in the order of their release time.
+func (t *Timeline) Entries() []TimelineEntry {
+	return append([]TimelineEntry(nil), t.entries...)
+}
+
+// Released returns the release time of a version equal to v.
+func (t *Timeline) Released(v *Version) (time.Time, bool) {
+	for _, e := range t.entries {
+		if e.Version.Equals(v) {
+			return e.Released, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// LatestAsOf returns the version without pre-release with the highest precedence released at or before the given
+// time. A maintenance release of an older line published later does not replace a newer version.
+func (t *Timeline) LatestAsOf(at time.Time) (*Version, bool) {
+	var latest *Version
+	for _, e := range t.entries {
+		if e.Released.After(at) {
+			break
+		}
+		if e.Version.PreRelease == "" && (latest == nil || latest.Before(e.Version)) {
+			latest = e.Version
+		}
+	}
+	return latest, latest != nil
+}
+
+// Between returns the entries released at or after from and before to in the order of their release time.
+func (t *Timeline) Between(from, to time.Time) []TimelineEntry {
+	var result []TimelineEntry
+	for _, e := range t.entries {
+		if !e.Released.Before(from) && e.Released.Before(to) {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+// StalenessDays returns the number of full days between the first release of a version without pre-release with a
+// higher precedence than v and now. It is 0 if no newer version was released at or before now.
+func (t *Timeline) StalenessDays(v *Version, now time.Time) int {
+	for _, e := range t.entries {
+		if e.Released.After(now) {
+			break
+		}
+		if e.Version.PreRelease == "" && v.Before(e.Version) {
+			return int(now.Sub(e.Released) / (24 * time.Hour))
+		}
+	}
+	return 0
+}
+
+// SetEOL sets the end of life of a release line, e.g. a Pattern like "1.2.*-*" (a pattern without pre-release part
+// does not match pre-releases). A version is unsupported from the end of life of the last set line that matches it
+// on, so a later call overrides the end of life of an earlier line for the versions it matches, e.g. "1.0.*-*" after
+// "1.*-*". Versions without a matching line are supported indefinitely.
+func (t *Timeline) SetEOL(line Matcher, eol time.Time) {
+	t.eols = append(t.eols, lineEOL{line: line, eol: eol})
+}
+
+// NextEOL returns the end of life of the release line of v.
+func (t *Timeline) NextEOL(v *Version) (time.Time, bool) {
+	for i := len(t.eols) - 1; i >= 0; i-- {
+		if l := t.eols[i]; l.line.Match(v) {
+			return l.eol, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// IsSupported determines if v has not reached the end of life of its release line at the given time.
+func (t *Timeline) IsSupported(v *Version, at time.Time) bool {
+	eol, ok := t.NextEOL(v)
+	return !ok || at.Before(eol)
+}
+
+// IsEOLAt returns a function that tells if a version has reached its end of life at the given time, e.g. for
+// AnalyzeCoverage.
+func (t *Timeline) IsEOLAt(at time.Time) func(*Version) bool {
+	return func(v *Version) bool {
+		return !t.IsSupported(v, at)
+	}
+}
@@ -0,0 +1,145 @@
+package semver_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/networkteam/semver"
+)
+
+func TestTimeline(t *testing.T) {
+	date := func(s string) time.Time {
+		d, err := time.Parse(time.DateOnly, s)
+		if err != nil {
+			t.Fatalf("Error parsing date %q: %v", s, err)
+		}
+		return d
+	}
+
+	timeline := semver.NewTimeline(
+		semver.TimelineEntry{Version: mustParse(t, "1.0.0"), Released: date("2024-01-10")},
+		semver.TimelineEntry{Version: mustParse(t, "2.0.0"), Released: date("2024-06-01")},
+		semver.TimelineEntry{Version: mustParse(t, "1.1.0"), Released: date("2024-03-01")},
+		semver.TimelineEntry{Version: mustParse(t, "1.1.1"), Released: date("2024-07-01")},
+		semver.TimelineEntry{Version: mustParse(t, "2.1.0-rc.1"), Released: date("2024-08-01")},
+	)
+
+	tests := []struct {
+		at       string
+		expected string
+	}{
+		{"2024-01-01", "<nil>"},
+		{"2024-01-10", "1.0.0"},
+		{"2024-05-01", "1.1.0"},
+		{"2024-07-15", "2.0.0"},
+		{"2024-09-01", "2.0.0"},
+	}
+	for _, test := range tests {
+		latest, _ := timeline.LatestAsOf(date(test.at))
+		if fmt.Sprint(latest) != test.expected {
+			t.Errorf("Expected latest as of %s to be %s, got %v", test.at, test.expected, latest)
+		}
+	}
+
+	var between []string
+	for _, e := range timeline.Between(date("2024-03-01"), date("2024-07-01")) {
+		between = append(between, e.Version.String())
+	}
+	if fmt.Sprint(between) != "[1.1.0 2.0.0]" {
+		t.Errorf("Unexpected versions between dates %v", between)
+	}
+
+	if days := timeline.StalenessDays(mustParse(t, "1.0.0"), date("2024-03-11")); days != 10 {
+		t.Errorf("Expected staleness of 10 days, got %d", days)
+	}
+	if days := timeline.StalenessDays(mustParse(t, "2.0.0"), date("2024-09-01")); days != 0 {
+		t.Errorf("Expected no staleness, got %d", days)
+	}
+	if released, ok := timeline.Released(mustParse(t, "1.1.1")); !ok || !released.Equal(date("2024-07-01")) {
+		t.Errorf("Unexpected release date %v", released)
+	}
+}
+
+func TestTimeline_EOL(t *testing.T) {
+	date := func(s string) time.Time {
+		d, err := time.Parse(time.DateOnly, s)
+		if err != nil {
+			t.Fatalf("Error parsing date %q: %v", s, err)
+		}
+		return d
+	}
+	pattern := func(s string) *semver.Pattern {
+		p, err := semver.CompilePattern(s)
+		if err != nil {
+			t.Fatalf("Error compiling pattern %q: %v", s, err)
+		}
+		return p
+	}
+
+	timeline := &semver.Timeline{}
+	timeline.SetEOL(pattern("1.*-*"), date("2025-01-01"))
+	timeline.SetEOL(pattern("1.0.*-*"), date("2024-01-01"))
+	// The last end of life set for a line wins
+	timeline.SetEOL(pattern("1.0.*-*"), date("2024-06-01"))
+
+	tests := []struct {
+		version   string
+		at        string
+		supported bool
+		eol       string
+	}{
+		{"1.0.3", "2024-05-31", true, "2024-06-01"},
+		{"1.0.3", "2024-06-01", false, "2024-06-01"},
+		{"1.1.0-rc.1", "2024-06-01", true, "2025-01-01"},
+		{"1.1.0", "2025-02-01", false, "2025-01-01"},
+		{"2.0.0", "2030-01-01", true, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version+"@"+test.at, func(t *testing.T) {
+			v := mustParse(t, test.version)
+			if supported := timeline.IsSupported(v, date(test.at)); supported != test.supported {
+				t.Errorf("Expected supported to be %v, got %v", test.supported, supported)
+			}
+			eol, ok := timeline.NextEOL(v)
+			if ok != (test.eol != "") || ok && eol.Format(time.DateOnly) != test.eol {
+				t.Errorf("Expected end of life %q, got %v", test.eol, eol)
+			}
+		})
+	}
+
+	expr, err := semver.CompileExpr(`major == 1`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	c := semver.AnalyzeCoverage(expr, []*semver.Version{mustParse(t, "1.0.0"), mustParse(t, "1.1.0")}, timeline.IsEOLAt(date("2024-07-01")))
+	if fmt.Sprint(versionStrings(c.MatchedEOL)) != "[1.0.0]" {
+		t.Errorf("Unexpected versions at end of life %v", versionStrings(c.MatchedEOL))
+	}
+}
+
+func TestChangelog_Timeline(t *testing.T) {
+	changelog, err := semver.ParseChangelog(strings.NewReader(`# Changelog
+
+## [Unreleased]
+
+## [1.1.0] - 2024-03-01
+
+## [1.0.1] - 2024-02-01 [YANKED]
+
+## [1.0.0] - 2024-01-10
+`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var versions []string
+	for _, e := range changelog.Timeline().Entries() {
+		versions = append(versions, e.Version.String())
+	}
+	if fmt.Sprint(versions) != "[1.0.0 1.1.0]" {
+		t.Errorf("Unexpected versions %v", versions)
+	}
+}
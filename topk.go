@@ -0,0 +1,51 @@
+package semver
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// TopK returns the k versions with the highest precedence in descending order, e.g. to show the newest releases of
+// a large list without sorting it. Pre-releases are skipped unless includePreRelease is true.
+func TopK(versions []*Version, k int, includePreRelease bool) []*Version {
+	if k <= 0 {
+		return nil
+	}
+
+	h := &versionHeap{}
+	for _, v := range versions {
+		if v.PreRelease != "" && !includePreRelease {
+			continue
+		}
+		if h.Len() < k {
+			heap.Push(h, v)
+		} else if (*h)[0].Before(v) {
+			(*h)[0] = v
+			heap.Fix(h, 0)
+		}
+	}
+
+	result := []*Version(*h)
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[j].Before(result[i])
+	})
+	return result
+}
+
+// versionHeap is a min-heap of versions by precedence.
+type versionHeap []*Version
+
+func (h versionHeap) Len() int           { return len(h) }
+func (h versionHeap) Less(i, j int) bool { return h[i].Before(h[j]) }
+func (h versionHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *versionHeap) Push(x any) {
+	*h = append(*h, x.(*Version))
+}
+
+func (h *versionHeap) Pop() any {
+	old := *h
+	v := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return v
+}
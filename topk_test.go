@@ -0,0 +1,35 @@
+package semver_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestTopK(t *testing.T) {
+	var versions []*semver.Version
+	for _, s := range []string{"1.0.0", "2.1.0", "0.9.0", "2.0.0-rc.1", "3.0.0-beta", "1.10.0", "2.0.0", "1.9.0"} {
+		versions = append(versions, mustParse(t, s))
+	}
+
+	tests := []struct {
+		k                 int
+		includePreRelease bool
+		expected          string
+	}{
+		{3, false, "[2.1.0 2.0.0 1.10.0]"},
+		{3, true, "[3.0.0-beta 2.1.0 2.0.0]"},
+		{20, false, "[2.1.0 2.0.0 1.10.0 1.9.0 1.0.0 0.9.0]"},
+		{0, true, "[]"},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%d/%v", test.k, test.includePreRelease), func(t *testing.T) {
+			got := semver.TopK(versions, test.k, test.includePreRelease)
+			if fmt.Sprint(versionStrings(got)) != test.expected {
+				t.Errorf("Expected %s, got %v", test.expected, versionStrings(got))
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PreRelease is a validated pre-release of a version (e.g. "rc.1").
+// Unlike the PreRelease field of Version, a value can only be obtained by parsing, so it always holds a valid
+// pre-release. The zero value is an empty pre-release, i.e. a release.
+type PreRelease struct {
+	s string
+}
+
+// ParsePreRelease parses a pre-release without the leading hyphen. An empty string returns the zero value.
+func ParsePreRelease(s string) (PreRelease, error) {
+	if err := validateIdentifiers(s, true); err != nil {
+		return PreRelease{}, fmt.Errorf("invalid pre-release %q: %w", s, err)
+	}
+	return PreRelease{s: s}, nil
+}
+
+// String returns the pre-release without the leading hyphen.
+func (p PreRelease) String() string {
+	return p.s
+}
+
+// IsZero determines if the pre-release is empty.
+func (p PreRelease) IsZero() bool {
+	return p.s == ""
+}
+
+// Compare compares the pre-release to other by SemVer precedence and returns -1, 0 or 1.
+// An empty pre-release has a higher precedence than any other pre-release.
+func (p PreRelease) Compare(other PreRelease) int {
+	return comparePreRelease(p.s, other.s)
+}
+
+// Build is validated build metadata of a version (e.g. "sha.5114f85").
+// Like PreRelease, a value can only be obtained by parsing. The zero value is empty build metadata.
+type Build struct {
+	s string
+}
+
+// ParseBuild parses build metadata without the leading plus sign. An empty string returns the zero value.
+func ParseBuild(s string) (Build, error) {
+	if err := validateIdentifiers(s, false); err != nil {
+		return Build{}, fmt.Errorf("invalid build %q: %w", s, err)
+	}
+	return Build{s: s}, nil
+}
+
+// String returns the build metadata without the leading plus sign.
+func (b Build) String() string {
+	return b.s
+}
+
+// IsZero determines if the build metadata is empty.
+func (b Build) IsZero() bool {
+	return b.s == ""
+}
+
+// validateIdentifiers checks the dot-separated identifiers of a non-empty pre-release or build.
+func validateIdentifiers(s string, preRelease bool) error {
+	if s == "" {
+		return nil
+	}
+	for _, identifier := range strings.Split(s, ".") {
+		if err := validateIdentifier(identifier, preRelease); err != nil {
+			return fmt.Errorf("identifier %q: %w", identifier, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestParsePreRelease(t *testing.T) {
+	tests := []struct {
+		input       string
+		expectedErr string
+	}{
+		{"", ""},
+		{"rc.1", ""},
+		{"alpha.beta-2", ""},
+		{"rc.01", `invalid pre-release "rc.01": identifier "01": leading zero is not allowed`},
+		{"alpha..1", `invalid pre-release "alpha..1": identifier "": identifier is empty`},
+		{"rc_1", `invalid pre-release "rc_1": identifier "rc_1": character '_' is not allowed`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			p, err := semver.ParsePreRelease(test.input)
+			if err != nil {
+				if err.Error() != test.expectedErr {
+					t.Errorf("Expected error %q, got %q", test.expectedErr, err)
+				}
+				return
+			}
+			if test.expectedErr != "" {
+				t.Fatalf("Expected error %q, got nil", test.expectedErr)
+			}
+			if p.String() != test.input {
+				t.Errorf("Expected %q, got %q", test.input, p)
+			}
+			if p.IsZero() != (test.input == "") {
+				t.Errorf("Expected IsZero to be %v", test.input == "")
+			}
+		})
+	}
+}
+
+func TestPreRelease_Compare(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"alpha", "alpha.1", -1},
+		{"beta.2", "beta.11", -1},
+		{"rc.1", "", -1},
+		{"", "", 0},
+		{"rc.1", "beta", 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.a+" <=> "+test.b, func(t *testing.T) {
+			a, err := semver.ParsePreRelease(test.a)
+			if err != nil {
+				t.Fatalf("Error parsing pre-release %q: %v", test.a, err)
+			}
+			b, err := semver.ParsePreRelease(test.b)
+			if err != nil {
+				t.Fatalf("Error parsing pre-release %q: %v", test.b, err)
+			}
+			if result := a.Compare(b); result != test.expected {
+				t.Errorf("Expected %d, got %d", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestParseBuild(t *testing.T) {
+	if b, err := semver.ParseBuild("001.sha-5114f85"); err != nil || b.String() != "001.sha-5114f85" {
+		t.Errorf("Expected valid build, got %q, %v", b, err)
+	}
+	if _, err := semver.ParseBuild("linux+amd64"); err == nil {
+		t.Errorf("Expected error for invalid build")
+	}
+}
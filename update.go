@@ -0,0 +1,62 @@
+package semver
+
+// UpdatePolicy contains the rules for ShouldUpdate.
+type UpdatePolicy struct {
+	// AllowPreRelease allows updates to versions with a pre-release.
+	AllowPreRelease bool
+	// AllowMajor allows updates to a different major version.
+	AllowMajor bool
+	// Minimum is an optional minimum required version. If the current version is below it, an update to a candidate
+	// satisfying it is required regardless of the other rules.
+	Minimum *Version
+}
+
+// Reason explains the decision of ShouldUpdate.
+type Reason int
+
+const (
+	// ReasonNewer is given for an allowed update to a version with a higher precedence.
+	ReasonNewer Reason = iota + 1
+	// ReasonRequired is given for an update that is required by the minimum version.
+	ReasonRequired
+	// ReasonUpToDate is given if the candidate has no higher precedence than the current version.
+	ReasonUpToDate
+	// ReasonPreRelease is given if the candidate is a pre-release and pre-releases are not allowed.
+	ReasonPreRelease
+	// ReasonMajorChange is given if the candidate has a different major version and major updates are not allowed.
+	ReasonMajorChange
+)
+
+func (r Reason) String() string {
+	switch r {
+	case ReasonNewer:
+		return "newer version available"
+	case ReasonRequired:
+		return "update required by minimum version"
+	case ReasonUpToDate:
+		return "up to date"
+	case ReasonPreRelease:
+		return "candidate is a pre-release"
+	case ReasonMajorChange:
+		return "candidate is a new major version"
+	default:
+		return "unknown"
+	}
+}
+
+// ShouldUpdate decides if a self-updater should update from the current version to the candidate version.
+func ShouldUpdate(current, candidate *Version, policy UpdatePolicy) (bool, Reason) {
+	if current.AtLeast(candidate) {
+		return false, ReasonUpToDate
+	}
+	if policy.Minimum != nil && current.Before(policy.Minimum) && candidate.AtLeast(policy.Minimum) {
+		return true, ReasonRequired
+	}
+	if candidate.PreRelease != "" && !policy.AllowPreRelease {
+		return false, ReasonPreRelease
+	}
+	if candidate.Major != current.Major && !policy.AllowMajor {
+		return false, ReasonMajorChange
+	}
+	return true, ReasonNewer
+}
@@ -0,0 +1,46 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func TestShouldUpdate(t *testing.T) {
+	tests := []struct {
+		current        string
+		candidate      string
+		policy         semver.UpdatePolicy
+		minimum        string
+		expected       bool
+		expectedReason semver.Reason
+	}{
+		{"1.2.0", "1.3.0", semver.UpdatePolicy{}, "", true, semver.ReasonNewer},
+		{"1.2.0", "1.2.0+build.2", semver.UpdatePolicy{}, "", false, semver.ReasonUpToDate},
+		{"1.3.0", "1.2.0", semver.UpdatePolicy{}, "", false, semver.ReasonUpToDate},
+		{"1.2.0", "1.3.0-rc.1", semver.UpdatePolicy{}, "", false, semver.ReasonPreRelease},
+		{"1.2.0", "1.3.0-rc.1", semver.UpdatePolicy{AllowPreRelease: true}, "", true, semver.ReasonNewer},
+		{"1.2.0", "2.0.0", semver.UpdatePolicy{}, "", false, semver.ReasonMajorChange},
+		{"1.2.0", "2.0.0", semver.UpdatePolicy{AllowMajor: true}, "", true, semver.ReasonNewer},
+		{"1.2.0", "2.0.0", semver.UpdatePolicy{}, "2.0.0", true, semver.ReasonRequired},
+		{"1.2.0", "1.9.0", semver.UpdatePolicy{}, "2.0.0", true, semver.ReasonNewer},
+		{"2.1.0", "3.0.0", semver.UpdatePolicy{}, "2.0.0", false, semver.ReasonMajorChange},
+	}
+
+	for _, test := range tests {
+		t.Run(test.current+" -> "+test.candidate, func(t *testing.T) {
+			policy := test.policy
+			if test.minimum != "" {
+				policy.Minimum = mustParse(t, test.minimum)
+			}
+
+			result, reason := semver.ShouldUpdate(mustParse(t, test.current), mustParse(t, test.candidate), policy)
+			if result != test.expected {
+				t.Errorf("Expected %v, got %v", test.expected, result)
+			}
+			if reason != test.expectedReason {
+				t.Errorf("Expected reason %q, got %q", test.expectedReason, reason)
+			}
+		})
+	}
+}
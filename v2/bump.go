@@ -0,0 +1,49 @@
+package semver
+
+// NextMajor returns the next major release, e.g. 2.0.0 for 1.2.3.
+// A pre-release of a major version (e.g. 2.0.0-rc.1) is released as 2.0.0.
+func (v Version) NextMajor() Version {
+	if v.IsPreRelease() && v.minor == 0 && v.patch == 0 {
+		return New(v.major, 0, 0)
+	}
+	return New(v.major+1, 0, 0)
+}
+
+// NextMinor returns the next minor release, e.g. 1.3.0 for 1.2.3.
+// A pre-release of a minor version (e.g. 1.3.0-rc.1) is released as 1.3.0.
+func (v Version) NextMinor() Version {
+	if v.IsPreRelease() && v.patch == 0 {
+		return New(v.major, v.minor, 0)
+	}
+	return New(v.major, v.minor+1, 0)
+}
+
+// NextPatch returns the next patch release, e.g. 1.2.4 for 1.2.3.
+// A pre-release (e.g. 1.2.4-rc.1) is released as 1.2.4.
+func (v Version) NextPatch() Version {
+	if v.IsPreRelease() {
+		return New(v.major, v.minor, v.patch)
+	}
+	return New(v.major, v.minor, v.patch+1)
+}
+
+// WithPreRelease returns a copy of the version with the given pre-release, an empty string removes it.
+// The build metadata is kept.
+func (v Version) WithPreRelease(preRelease string) (Version, error) {
+	p, err := ParsePreRelease(preRelease)
+	if err != nil {
+		return Version{}, err
+	}
+	v.preRelease = p
+	return v, nil
+}
+
+// WithBuild returns a copy of the version with the given build metadata, an empty string removes it.
+func (v Version) WithBuild(build string) (Version, error) {
+	b, err := ParseBuild(build)
+	if err != nil {
+		return Version{}, err
+	}
+	v.build = b
+	return v, nil
+}
@@ -0,0 +1,39 @@
+package semver
+
+// Compare compares the version to other by SemVer precedence (ignoring the build metadata) and returns -1, 0 or 1.
+func (v Version) Compare(other Version) int {
+	if v.major != other.major {
+		return compareInts(v.major, other.major)
+	}
+	if v.minor != other.minor {
+		return compareInts(v.minor, other.minor)
+	}
+	if v.patch != other.patch {
+		return compareInts(v.patch, other.patch)
+	}
+	return v.preRelease.Compare(other.preRelease)
+}
+
+// Equals determines if the version has the same precedence as other (ignoring the build metadata).
+func (v Version) Equals(other Version) bool {
+	return v.Compare(other) == 0
+}
+
+// Before determines if the version has a lower precedence than other.
+func (v Version) Before(other Version) bool {
+	return v.Compare(other) < 0
+}
+
+// After determines if the version has a higher precedence than other.
+func (v Version) After(other Version) bool {
+	return v.Compare(other) > 0
+}
+
+func compareInts(a, b int) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
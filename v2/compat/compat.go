@@ -0,0 +1,96 @@
+// Package compat helps migrating from version 1 to version 2 of the semver package package-by-package.
+//
+// It converts between v1 *semver.Version and v2 semver.Version values and re-exports the core of the v1 API with the
+// same signatures: the Version, ParseOptions, ParseError, Matcher, Expr and Pattern types and the functions to parse
+// and normalize versions and to compile expressions and patterns. A package using only these can switch its import to
+// compat first and adopt the v2 types afterwards. Other parts of the v1 API (e.g. Range, Policy or VersionList) are
+// not re-exported and are still imported from version 1 of the package.
+package compat
+
+import (
+	"fmt"
+
+	v1 "github.com/networkteam/semver"
+	"github.com/networkteam/semver/v2"
+)
+
+// FromV1 converts a v1 version to a v2 version.
+// Since the fields of a v1 version can be assigned freely, it returns an error for a version that is not valid
+// (e.g. an invalid pre-release) or can't be represented in v2 (a non-zero epoch).
+func FromV1(v *v1.Version) (semver.Version, error) {
+	if v.Epoch != 0 {
+		return semver.Version{}, fmt.Errorf("version %s has an epoch, which is not supported by v2", v)
+	}
+	if v.Major < 0 || v.Minor < 0 || v.Patch < 0 {
+		return semver.Version{}, fmt.Errorf("version %s has a negative component", v)
+	}
+	converted, err := semver.New(v.Major, v.Minor, v.Patch).WithPreRelease(v.PreRelease)
+	if err != nil {
+		return semver.Version{}, err
+	}
+	return converted.WithBuild(v.Build)
+}
+
+// ToV1 converts a v2 version to a new v1 version.
+func ToV1(v semver.Version) *v1.Version {
+	return &v1.Version{
+		Major:      v.Major(),
+		Minor:      v.Minor(),
+		Patch:      v.Patch(),
+		PreRelease: v.PreRelease().String(),
+		Build:      v.Build().String(),
+	}
+}
+
+// Version is the v1 version type.
+type Version = v1.Version
+
+// ParseOptions are the v1 parse options.
+type ParseOptions = v1.ParseOptions
+
+// ParseError is the error returned for invalid versions.
+type ParseError = v1.ParseError
+
+// Matcher is the v1 matcher interface.
+type Matcher = v1.Matcher
+
+// Expr is a compiled v1 expression.
+type Expr = v1.Expr
+
+// Pattern is a compiled v1 pattern.
+type Pattern = v1.Pattern
+
+// ParseVersion calls v1 semver.ParseVersion.
+func ParseVersion(version string) (*Version, error) {
+	return v1.ParseVersion(version)
+}
+
+// ParseVersionWithOptions calls v1 semver.ParseVersionWithOptions.
+func ParseVersionWithOptions(version string, opts ParseOptions) (*Version, error) {
+	return v1.ParseVersionWithOptions(version, opts)
+}
+
+// FromParts calls v1 semver.FromParts.
+func FromParts(major, minor, patch uint64, pre []string, build []string) (*Version, error) {
+	return v1.FromParts(major, minor, patch, pre, build)
+}
+
+// Normalize calls v1 semver.Normalize.
+func Normalize(s string) (string, error) {
+	return v1.Normalize(s)
+}
+
+// CompileExpr calls v1 semver.CompileExpr.
+func CompileExpr(expr string) (*Expr, error) {
+	return v1.CompileExpr(expr)
+}
+
+// CompilePattern calls v1 semver.CompilePattern.
+func CompilePattern(pattern string) (*Pattern, error) {
+	return v1.CompilePattern(pattern)
+}
+
+// MatchPattern calls v1 semver.MatchPattern.
+func MatchPattern(pattern string, v *Version) (bool, error) {
+	return v1.MatchPattern(pattern, v)
+}
@@ -0,0 +1,60 @@
+package compat_test
+
+import (
+	"testing"
+
+	v1 "github.com/networkteam/semver"
+	"github.com/networkteam/semver/v2"
+	"github.com/networkteam/semver/v2/compat"
+)
+
+func TestFromV1(t *testing.T) {
+	tests := []struct {
+		name        string
+		version     *v1.Version
+		expected    string
+		expectedErr string
+	}{
+		{"valid", &v1.Version{Major: 1, Minor: 2, Patch: 3, PreRelease: "rc.1", Build: "linux"}, "1.2.3-rc.1+linux", ""},
+		{"invalid pre-release", &v1.Version{Major: 1, PreRelease: "rc.01"}, "", `invalid pre-release "rc.01": identifier "01": leading zero is not allowed`},
+		{"negative", &v1.Version{Major: -1}, "", "version -1.0.0 has a negative component"},
+		{"epoch", &v1.Version{Epoch: 1, Major: 1}, "", "version 1:1.0.0 has an epoch, which is not supported by v2"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v, err := compat.FromV1(test.version)
+			if err != nil {
+				if err.Error() != test.expectedErr {
+					t.Errorf("Expected error %q, got %q", test.expectedErr, err)
+				}
+				return
+			}
+			if test.expectedErr != "" {
+				t.Fatalf("Expected error %q, got nil", test.expectedErr)
+			}
+			if v.String() != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, v)
+			}
+		})
+	}
+}
+
+func TestToV1(t *testing.T) {
+	v := compat.ToV1(semver.MustParse("1.2.3-rc.1+linux"))
+	if v.Major != 1 || v.Minor != 2 || v.Patch != 3 || v.PreRelease != "rc.1" || v.Build != "linux" {
+		t.Errorf("Unexpected version %+v", v)
+	}
+}
+
+func TestParseVersion(t *testing.T) {
+	v, err := compat.ParseVersion("1.2.3")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// A compat version is a v1 version.
+	var _ *v1.Version = v
+	if _, err := compat.ParseVersion("1.2"); err == nil {
+		t.Errorf("Expected error for invalid version")
+	}
+}
@@ -0,0 +1,143 @@
+// Package semver is version 2 of the semver package.
+//
+// Version is an immutable value type: its components can only be read with getters and every change (e.g. a bump)
+// returns a new Version. Values are obtained by parsing or with New, so a Version is always valid. The zero value is
+// the version 0.0.0.
+//
+// Parsing and precedence follow the SemVer 2.0 spec and are shared with version 1 of the package, so both versions
+// order versions the same way. Version 2 is stricter: it rejects numeric pre-release identifiers with leading zeros
+// (e.g. "1.2.3-0123") and empty identifiers (e.g. "1.0.0-alpha..1"), which version 1 accepts. Options of version 1
+// like epochs or the defaults of SetDefaultParseOptions do not apply.
+package semver
+
+import (
+	"fmt"
+
+	v1 "github.com/networkteam/semver"
+)
+
+// PreRelease is a validated pre-release of a version.
+type PreRelease = v1.PreRelease
+
+// Build is validated build metadata of a version.
+type Build = v1.Build
+
+// ParsePreRelease parses a pre-release without the leading hyphen.
+func ParsePreRelease(s string) (PreRelease, error) {
+	return v1.ParsePreRelease(s)
+}
+
+// ParseBuild parses build metadata without the leading plus sign.
+func ParseBuild(s string) (Build, error) {
+	return v1.ParseBuild(s)
+}
+
+// ParseError is the error returned for invalid versions, see the Kind for a stable classification.
+type ParseError = v1.ParseError
+
+// Version is an immutable semantic version.
+type Version struct {
+	major      int
+	minor      int
+	patch      int
+	preRelease PreRelease
+	build      Build
+}
+
+// New returns the release version major.minor.patch. It panics if a component is negative.
+func New(major, minor, patch int) Version {
+	if major < 0 || minor < 0 || patch < 0 {
+		panic(fmt.Sprintf("semver: negative version component in %d.%d.%d", major, minor, patch))
+	}
+	return Version{major: major, minor: minor, patch: patch}
+}
+
+// Parse parses a semantic version string and returns a Version or an error if the version is invalid.
+func Parse(version string) (Version, error) {
+	v, err := v1.ParseVersionWithOptions(version, v1.ParseOptions{})
+	if err != nil {
+		return Version{}, err
+	}
+	return fromV1(v)
+}
+
+// MustParse is like Parse but panics if the version is invalid. It simplifies the initialization of variables
+// with constant versions.
+func MustParse(version string) Version {
+	v, err := Parse(version)
+	if err != nil {
+		panic(fmt.Sprintf("semver: Parse(%q): %v", version, err))
+	}
+	return v
+}
+
+// fromV1 converts a version parsed by version 1 of the package, validating the pre-release and build with the
+// stricter rules of version 2.
+func fromV1(v *v1.Version) (Version, error) {
+	pre, err := ParsePreRelease(v.PreRelease)
+	if err != nil {
+		return Version{}, err
+	}
+	build, err := ParseBuild(v.Build)
+	if err != nil {
+		return Version{}, err
+	}
+	return Version{major: v.Major, minor: v.Minor, patch: v.Patch, preRelease: pre, build: build}, nil
+}
+
+// Major returns the major version.
+func (v Version) Major() int {
+	return v.major
+}
+
+// Minor returns the minor version.
+func (v Version) Minor() int {
+	return v.minor
+}
+
+// Patch returns the patch version.
+func (v Version) Patch() int {
+	return v.patch
+}
+
+// PreRelease returns the pre-release, which is zero for a release.
+func (v Version) PreRelease() PreRelease {
+	return v.preRelease
+}
+
+// Build returns the build metadata.
+func (v Version) Build() Build {
+	return v.build
+}
+
+// IsPreRelease determines if the version has a pre-release.
+func (v Version) IsPreRelease() bool {
+	return !v.preRelease.IsZero()
+}
+
+// String returns the string representation of the version.
+func (v Version) String() string {
+	version := fmt.Sprintf("%d.%d.%d", v.major, v.minor, v.patch)
+	if !v.preRelease.IsZero() {
+		version += "-" + v.preRelease.String()
+	}
+	if !v.build.IsZero() {
+		version += "+" + v.build.String()
+	}
+	return version
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v Version) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *Version) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
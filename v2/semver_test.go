@@ -0,0 +1,172 @@
+package semver_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	v1 "github.com/networkteam/semver"
+	"github.com/networkteam/semver/v2"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		version     string
+		major       int
+		minor       int
+		patch       int
+		preRelease  string
+		build       string
+		expectedErr string
+	}{
+		{"1.2.3", 1, 2, 3, "", "", ""},
+		{"1.0.0-alpha+001", 1, 0, 0, "alpha", "001", ""},
+		{"1.0.0-beta+exp.sha.5114f85", 1, 0, 0, "beta", "exp.sha.5114f85", ""},
+		{"1.00.0", 0, 0, 0, "", "", "invalid version core: minor: leading zero is not allowed (at position 2)"},
+		{"1.2.3-0123", 0, 0, 0, "", "", `invalid pre-release "0123": identifier "0123": leading zero is not allowed`},
+		{"1.0.0-alpha..1", 0, 0, 0, "", "", `invalid pre-release "alpha..1": identifier "": identifier is empty`},
+		{"1.0.0+a..b", 0, 0, 0, "", "", `invalid build "a..b": identifier "": identifier is empty`},
+		{"1:1.0.0", 0, 0, 0, "", "", "invalid version core: missing dot separator (at position 1)"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			v, err := semver.Parse(test.version)
+			if err != nil {
+				if err.Error() != test.expectedErr {
+					t.Errorf("Expected error %q, got %q", test.expectedErr, err)
+				}
+				return
+			}
+			if test.expectedErr != "" {
+				t.Fatalf("Expected error %q, got nil", test.expectedErr)
+			}
+			if v.Major() != test.major || v.Minor() != test.minor || v.Patch() != test.patch {
+				t.Errorf("Expected %d.%d.%d, got %d.%d.%d", test.major, test.minor, test.patch, v.Major(), v.Minor(), v.Patch())
+			}
+			if v.PreRelease().String() != test.preRelease {
+				t.Errorf("Expected pre-release %q, got %q", test.preRelease, v.PreRelease())
+			}
+			if v.Build().String() != test.build {
+				t.Errorf("Expected build %q, got %q", test.build, v.Build())
+			}
+			if v.String() != test.version {
+				t.Errorf("Expected %q, got %q", test.version, v)
+			}
+		})
+	}
+}
+
+func TestParse_IgnoresV1Defaults(t *testing.T) {
+	v1.SetDefaultParseOptions(v1.ParseOptions{Epoch: true, AllowVPrefix: true})
+	t.Cleanup(func() { v1.SetDefaultParseOptions(v1.ParseOptions{}) })
+
+	for _, input := range []string{"1:2.0.0", "v1.2.3"} {
+		if _, err := semver.Parse(input); err == nil {
+			t.Errorf("Expected error for %q", input)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"1.0.0-alpha", "1.0.0", -1},
+		{"1.0.0-beta.2", "1.0.0-beta.11", -1},
+		{"1.10.0", "1.9.0", 1},
+		{"1.0.0+build1", "1.0.0+build2", 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.a+" <=> "+test.b, func(t *testing.T) {
+			a, b := semver.MustParse(test.a), semver.MustParse(test.b)
+			if result := a.Compare(b); result != test.expected {
+				t.Errorf("Expected %d, got %d", test.expected, result)
+			}
+			if a.Before(b) != (test.expected < 0) || a.After(b) != (test.expected > 0) || a.Equals(b) != (test.expected == 0) {
+				t.Errorf("Expected Before, After and Equals to agree with Compare")
+			}
+		})
+	}
+}
+
+func TestBump(t *testing.T) {
+	tests := []struct {
+		version   string
+		nextMajor string
+		nextMinor string
+		nextPatch string
+	}{
+		{"1.2.3", "2.0.0", "1.3.0", "1.2.4"},
+		{"1.2.3+build", "2.0.0", "1.3.0", "1.2.4"},
+		{"2.0.0-rc.1", "2.0.0", "2.0.0", "2.0.0"},
+		{"1.3.0-rc.1", "2.0.0", "1.3.0", "1.3.0"},
+		{"1.2.4-rc.1", "2.0.0", "1.3.0", "1.2.4"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			v := semver.MustParse(test.version)
+			if s := v.NextMajor().String(); s != test.nextMajor {
+				t.Errorf("Expected next major %q, got %q", test.nextMajor, s)
+			}
+			if s := v.NextMinor().String(); s != test.nextMinor {
+				t.Errorf("Expected next minor %q, got %q", test.nextMinor, s)
+			}
+			if s := v.NextPatch().String(); s != test.nextPatch {
+				t.Errorf("Expected next patch %q, got %q", test.nextPatch, s)
+			}
+			if v.String() != test.version {
+				t.Errorf("Expected original version to be unchanged, got %q", v)
+			}
+		})
+	}
+}
+
+func TestWithPreRelease(t *testing.T) {
+	v := semver.MustParse("1.2.3+linux")
+	rc, err := v.WithPreRelease("rc.1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if rc.String() != "1.2.3-rc.1+linux" {
+		t.Errorf("Expected %q, got %q", "1.2.3-rc.1+linux", rc)
+	}
+	if v.String() != "1.2.3+linux" {
+		t.Errorf("Expected original version to be unchanged, got %q", v)
+	}
+	if _, err := v.WithPreRelease("rc.01"); err == nil {
+		t.Errorf("Expected error for invalid pre-release")
+	}
+	if _, err := v.WithBuild("linux_amd64"); err == nil {
+		t.Errorf("Expected error for invalid build")
+	}
+}
+
+func TestVersion_JSON(t *testing.T) {
+	var decoded struct{ Version semver.Version }
+	if err := json.Unmarshal([]byte(`{"Version":"1.2.3-rc.1"}`), &decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	data, err := json.Marshal(decoded)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(data) != `{"Version":"1.2.3-rc.1"}` {
+		t.Errorf("Expected round trip, got %s", data)
+	}
+	if err := json.Unmarshal([]byte(`{"Version":"1.2"}`), &decoded); err == nil {
+		t.Errorf("Expected error for invalid version")
+	}
+}
+
+func TestZeroValue(t *testing.T) {
+	var v semver.Version
+	if v.String() != "0.0.0" {
+		t.Errorf("Expected %q, got %q", "0.0.0", v)
+	}
+	if !v.Equals(semver.New(0, 0, 0)) {
+		t.Errorf("Expected zero value to equal 0.0.0")
+	}
+}
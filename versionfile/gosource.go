@@ -0,0 +1,73 @@
+package versionfile
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"github.com/networkteam/semver"
+)
+
+// GoConst returns a source for a string constant or variable declared at package level in a Go source file:
+//
+//	const Version = "1.2.3"
+//
+// The quote style of the literal is preserved when writing.
+func GoConst(path, name string) Source {
+	return fileSource{path: path, loc: goConstLocator{name: name}}
+}
+
+type goConstLocator struct {
+	name string
+}
+
+func (l goConstLocator) locate(data []byte) (span, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", data, 0)
+	if err != nil {
+		return span{}, err
+	}
+
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || (gen.Tok != token.CONST && gen.Tok != token.VAR) {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			valueSpec := spec.(*ast.ValueSpec)
+			for i, ident := range valueSpec.Names {
+				if ident.Name != l.name {
+					continue
+				}
+				if i >= len(valueSpec.Values) {
+					return span{}, fmt.Errorf("%s has no value", l.name)
+				}
+				lit, ok := valueSpec.Values[i].(*ast.BasicLit)
+				if !ok || lit.Kind != token.STRING {
+					return span{}, fmt.Errorf("%s is not a string literal", l.name)
+				}
+				value, err := strconv.Unquote(lit.Value)
+				if err != nil {
+					return span{}, err
+				}
+				return span{
+					start: fset.Position(lit.Pos()).Offset,
+					end:   fset.Position(lit.End()).Offset,
+					value: value,
+				}, nil
+			}
+		}
+	}
+
+	return span{}, fmt.Errorf("declaration of %s not found", l.name)
+}
+
+func (l goConstLocator) render(s span, data []byte, v *semver.Version) string {
+	if strings.HasPrefix(string(data[s.start:s.end]), "`") {
+		return "`" + v.String() + "`"
+	}
+	return strconv.Quote(v.String())
+}
@@ -0,0 +1,74 @@
+package versionfile
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/networkteam/semver"
+)
+
+// JSONKey returns a source for a string value in a JSON file at the given path of object keys, e.g.
+// JSONKey("package.json", "version"). The formatting of the file is preserved when writing.
+func JSONKey(path string, keys ...string) Source {
+	return fileSource{path: path, loc: jsonLocator{keys: keys}}
+}
+
+type jsonLocator struct {
+	keys []string
+}
+
+func (l jsonLocator) locate(data []byte) (span, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	for depth, key := range l.keys {
+		if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+			return span{}, fmt.Errorf("%s is not an object", strings.Join(l.keys[:depth], "."))
+		}
+
+		for {
+			tok, err := dec.Token()
+			if err != nil {
+				return span{}, err
+			}
+			if tok == json.Delim('}') {
+				return span{}, fmt.Errorf("key %s not found", strings.Join(l.keys[:depth+1], "."))
+			}
+			if tok != key {
+				var skipped json.RawMessage
+				if err := dec.Decode(&skipped); err != nil {
+					return span{}, err
+				}
+				continue
+			}
+			break
+		}
+	}
+
+	// The value starts after the colon following the key
+	start := int(dec.InputOffset())
+	for start < len(data) && (data[start] == ':' || data[start] == ' ' || data[start] == '\t' || data[start] == '\r' || data[start] == '\n') {
+		start++
+	}
+
+	tok, err := dec.Token()
+	if err == io.EOF {
+		return span{}, io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return span{}, err
+	}
+	value, ok := tok.(string)
+	if !ok {
+		return span{}, fmt.Errorf("%s is not a string", strings.Join(l.keys, "."))
+	}
+
+	return span{start: start, end: int(dec.InputOffset()), value: value}, nil
+}
+
+func (l jsonLocator) render(_ span, _ []byte, v *semver.Version) string {
+	quoted, _ := json.Marshal(v.String())
+	return string(quoted)
+}
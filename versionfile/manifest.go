@@ -0,0 +1,50 @@
+package versionfile
+
+import (
+	"errors"
+
+	"github.com/networkteam/semver"
+)
+
+// PackageJSON returns a source for the version field of an npm package.json file.
+func PackageJSON(path string) Source {
+	return JSONKey(path, "version")
+}
+
+// CargoTOML returns a source for the package version of a Rust Cargo.toml file.
+func CargoTOML(path string) Source {
+	return TOMLKey(path, "package", "version")
+}
+
+// PyProject returns a source for the project version of a Python pyproject.toml file.
+// The version is looked up in the [project] table (PEP 621) and in the [tool.poetry] table.
+func PyProject(path string) Source {
+	return fileSource{path: path, loc: firstLocator{
+		tomlLocator{table: "project", key: "version"},
+		tomlLocator{table: "tool.poetry", key: "version"},
+	}}
+}
+
+// firstLocator uses the first locator that finds a value.
+type firstLocator []locator
+
+func (l firstLocator) locate(data []byte) (span, error) {
+	var errs []error
+	for _, loc := range l {
+		s, err := loc.locate(data)
+		if err == nil {
+			return s, nil
+		}
+		errs = append(errs, err)
+	}
+	return span{}, errors.Join(errs...)
+}
+
+func (l firstLocator) render(s span, data []byte, v *semver.Version) string {
+	for _, loc := range l {
+		if found, err := loc.locate(data); err == nil && found == s {
+			return loc.render(s, data, v)
+		}
+	}
+	return v.String()
+}
@@ -0,0 +1,69 @@
+package versionfile
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/networkteam/semver"
+)
+
+// TOMLKey returns a source for a string value of a key in a table of a TOML file, e.g.
+// TOMLKey("Cargo.toml", "package", "version"). The formatting of the file is preserved when writing.
+//
+// Only keys with a basic or literal string value on the same line inside a [table] section are supported.
+func TOMLKey(path, table, key string) Source {
+	return fileSource{path: path, loc: tomlLocator{table: table, key: key}}
+}
+
+type tomlLocator struct {
+	table string
+	key   string
+}
+
+func (l tomlLocator) locate(data []byte) (span, error) {
+	inTable := false
+
+	offset := 0
+	for _, line := range strings.SplitAfter(string(data), "\n") {
+		lineStart := offset
+		offset += len(line)
+
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "[") {
+			header := strings.TrimSpace(strings.Trim(trimmed, "[]"))
+			inTable = !strings.HasPrefix(trimmed, "[[") && header == l.table
+			continue
+		}
+		if !inTable {
+			continue
+		}
+
+		key, rest, found := strings.Cut(line, "=")
+		if !found || strings.Trim(strings.TrimSpace(key), `"'`) != l.key {
+			continue
+		}
+
+		value := strings.TrimLeft(rest, " \t")
+		if value == "" || (value[0] != '"' && value[0] != '\'') {
+			return span{}, fmt.Errorf("%s.%s is not a string", l.table, l.key)
+		}
+		end := strings.IndexByte(value[1:], value[0])
+		if end < 0 {
+			return span{}, fmt.Errorf("%s.%s has an unterminated string", l.table, l.key)
+		}
+
+		start := lineStart + len(key) + 1 + len(rest) - len(value)
+		return span{
+			start: start,
+			end:   start + end + 2,
+			value: value[1 : end+1],
+		}, nil
+	}
+
+	return span{}, fmt.Errorf("key %s.%s not found", l.table, l.key)
+}
+
+func (l tomlLocator) render(s span, data []byte, v *semver.Version) string {
+	quote := string(data[s.start])
+	return quote + v.String() + quote
+}
@@ -0,0 +1,123 @@
+// Package versionfile reads and writes the version of a project from and to files in the source tree.
+//
+// Each Source rewrites only the version itself and preserves the rest of the file. Files are written atomically by
+// writing a temporary file next to the original and renaming it.
+package versionfile
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/networkteam/semver"
+)
+
+// Source is a location in a file that holds a version.
+type Source interface {
+	// Read reads the version.
+	Read() (*semver.Version, error)
+	// Write replaces the version.
+	Write(v *semver.Version) error
+}
+
+// span is a value found in the content of a file.
+type span struct {
+	start, end int
+	value      string
+}
+
+// locator finds the span of the version in the content of a file and renders a replacement for it.
+type locator interface {
+	locate(data []byte) (span, error)
+	render(s span, data []byte, v *semver.Version) string
+}
+
+// fileSource implements Source for a locator.
+type fileSource struct {
+	path string
+	loc  locator
+}
+
+func (s fileSource) Read() (*semver.Version, error) {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, err
+	}
+	sp, err := s.loc.locate(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", s.path, err)
+	}
+	v, err := semver.ParseVersionWithOptions(sp.value, semver.ParseOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("%s: invalid version %q: %w", s.path, sp.value, err)
+	}
+	return v, nil
+}
+
+func (s fileSource) Write(v *semver.Version) error {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return err
+	}
+	sp, err := s.loc.locate(data)
+	if err != nil {
+		return fmt.Errorf("%s: %w", s.path, err)
+	}
+
+	var buf []byte
+	buf = append(buf, data[:sp.start]...)
+	buf = append(buf, s.loc.render(sp, data, v)...)
+	buf = append(buf, data[sp.end:]...)
+	return writeFileAtomic(s.path, buf)
+}
+
+// Plain returns a source for a file that only contains the version, like a VERSION file.
+// Surrounding whitespace is ignored when reading and preserved when writing.
+func Plain(path string) Source {
+	return fileSource{path: path, loc: plainLocator{}}
+}
+
+type plainLocator struct{}
+
+func (plainLocator) locate(data []byte) (span, error) {
+	s := string(data)
+	start := len(s) - len(strings.TrimLeft(s, " \t\r\n"))
+	end := len(strings.TrimRight(s, " \t\r\n"))
+	if start >= end {
+		return span{}, fmt.Errorf("empty file")
+	}
+	return span{start: start, end: end, value: s[start:end]}, nil
+}
+
+func (plainLocator) render(_ span, _ []byte, v *semver.Version) string {
+	return v.String()
+}
+
+// writeFileAtomic writes data to a temporary file in the directory of path and renames it to path,
+// keeping the permissions of an existing file.
+func writeFileAtomic(path string, data []byte) error {
+	perm := os.FileMode(0o644)
+	if info, err := os.Stat(path); err == nil {
+		perm = info.Mode().Perm()
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Chmod(perm); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
@@ -0,0 +1,160 @@
+package versionfile_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/networkteam/semver"
+	"github.com/networkteam/semver/versionfile"
+)
+
+func TestSources(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		content  string
+		source   func(path string) versionfile.Source
+		version  string
+		expected string
+	}{
+		{
+			name:     "plain",
+			filename: "VERSION",
+			content:  "1.2.3\n",
+			source:   versionfile.Plain,
+			version:  "1.2.3",
+			expected: "1.3.0-rc.1\n",
+		},
+		{
+			name:     "go const",
+			filename: "version.go",
+			content:  "package main\n\n// Version of the app\nconst Version = \"1.2.3\" // bumped by CI\n",
+			source:   func(path string) versionfile.Source { return versionfile.GoConst(path, "Version") },
+			version:  "1.2.3",
+			expected: "package main\n\n// Version of the app\nconst Version = \"1.3.0-rc.1\" // bumped by CI\n",
+		},
+		{
+			name:     "go var in group with raw string",
+			filename: "version.go",
+			content:  "package main\n\nvar (\n\tName    = \"app\"\n\tVersion = `1.2.3`\n)\n",
+			source:   func(path string) versionfile.Source { return versionfile.GoConst(path, "Version") },
+			version:  "1.2.3",
+			expected: "package main\n\nvar (\n\tName    = \"app\"\n\tVersion = `1.3.0-rc.1`\n)\n",
+		},
+		{
+			name:     "json",
+			filename: "meta.json",
+			content:  "{\n  \"name\": \"app\",\n  \"release\": {\n    \"tags\": [\"a\", {\"version\": \"0.0.1\"}],\n    \"version\" :  \"1.2.3\"\n  }\n}\n",
+			source:   func(path string) versionfile.Source { return versionfile.JSONKey(path, "release", "version") },
+			version:  "1.2.3",
+			expected: "{\n  \"name\": \"app\",\n  \"release\": {\n    \"tags\": [\"a\", {\"version\": \"0.0.1\"}],\n    \"version\" :  \"1.3.0-rc.1\"\n  }\n}\n",
+		},
+		{
+			name:     "yaml",
+			filename: "Chart.yaml",
+			content:  "name: app\ndependencies:\n  version: 0.0.1\napp:\n  # The app version\n  image:\n    version: 0.0.2\n  version: \"1.2.3\" # bumped by CI\n",
+			source:   func(path string) versionfile.Source { return versionfile.YAMLKey(path, "app", "version") },
+			version:  "1.2.3",
+			expected: "name: app\ndependencies:\n  version: 0.0.1\napp:\n  # The app version\n  image:\n    version: 0.0.2\n  version: \"1.3.0-rc.1\" # bumped by CI\n",
+		},
+		{
+			name:     "package.json",
+			filename: "package.json",
+			content:  "{\n\t\"name\": \"app\",\n\t\"version\": \"1.2.3\",\n\t\"dependencies\": {\"x\": \"^1.0.0\"}\n}\n",
+			source:   versionfile.PackageJSON,
+			version:  "1.2.3",
+			expected: "{\n\t\"name\": \"app\",\n\t\"version\": \"1.3.0-rc.1\",\n\t\"dependencies\": {\"x\": \"^1.0.0\"}\n}\n",
+		},
+		{
+			name:     "Cargo.toml",
+			filename: "Cargo.toml",
+			content:  "[package]\nname = \"app\"\nversion    = \"1.2.3\" # bumped by CI\n\n[dependencies]\nversion = \"0.1.0\"\n",
+			source:   versionfile.CargoTOML,
+			version:  "1.2.3",
+			expected: "[package]\nname = \"app\"\nversion    = \"1.3.0-rc.1\" # bumped by CI\n\n[dependencies]\nversion = \"0.1.0\"\n",
+		},
+		{
+			name:     "pyproject.toml",
+			filename: "pyproject.toml",
+			content:  "[build-system]\nrequires = [\"hatchling\"]\n\n[project]\nname = 'app'\nversion = '1.2.3'\n",
+			source:   versionfile.PyProject,
+			version:  "1.2.3",
+			expected: "[build-system]\nrequires = [\"hatchling\"]\n\n[project]\nname = 'app'\nversion = '1.3.0-rc.1'\n",
+		},
+		{
+			name:     "pyproject.toml with poetry",
+			filename: "pyproject.toml",
+			content:  "[tool.poetry]\nname = \"app\"\nversion = \"1.2.3\"\n",
+			source:   versionfile.PyProject,
+			version:  "1.2.3",
+			expected: "[tool.poetry]\nname = \"app\"\nversion = \"1.3.0-rc.1\"\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), test.filename)
+			if err := os.WriteFile(path, []byte(test.content), 0o600); err != nil {
+				t.Fatalf("Error writing file: %v", err)
+			}
+			source := test.source(path)
+
+			v, err := source.Read()
+			if err != nil {
+				t.Fatalf("Error reading version: %v", err)
+			}
+			if v.String() != test.version {
+				t.Errorf("Expected version %q, got %q", test.version, v)
+			}
+
+			next, _ := semver.ParseVersion("1.3.0-rc.1")
+			if err := source.Write(next); err != nil {
+				t.Fatalf("Error writing version: %v", err)
+			}
+
+			content, _ := os.ReadFile(path)
+			if string(content) != test.expected {
+				t.Errorf("Expected content %q, got %q", test.expected, content)
+			}
+			info, _ := os.Stat(path)
+			if info.Mode().Perm() != 0o600 {
+				t.Errorf("Expected permissions 0600 to be kept, got %v", info.Mode().Perm())
+			}
+		})
+	}
+}
+
+func TestSources_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		source      func(path string) versionfile.Source
+		expectedErr string
+	}{
+		{"empty plain", "\n", versionfile.Plain, "empty file"},
+		{"invalid plain", "1.2\n", versionfile.Plain, `invalid version "1.2": invalid version core: missing dot separator (at position 3)`},
+		{"missing go const", "package main\n", func(path string) versionfile.Source { return versionfile.GoConst(path, "Version") }, "declaration of Version not found"},
+		{"missing json key", `{"name": "app"}`, func(path string) versionfile.Source { return versionfile.JSONKey(path, "version") }, "key version not found"},
+		{"json number", `{"version": 1}`, func(path string) versionfile.Source { return versionfile.JSONKey(path, "version") }, "version is not a string"},
+		{"missing toml key", "[package]\nname = \"app\"\n", versionfile.CargoTOML, "key package.version not found"},
+		{"missing yaml key", "app:\n  name: x\nversion: 1.0.0\n", func(path string) versionfile.Source { return versionfile.YAMLKey(path, "app", "version") }, "key app.version not found"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "file")
+			if err := os.WriteFile(path, []byte(test.content), 0o644); err != nil {
+				t.Fatalf("Error writing file: %v", err)
+			}
+
+			_, err := test.source(path).Read()
+			if err == nil {
+				t.Fatalf("Expected error %q, got nil", test.expectedErr)
+			}
+			if expected := path + ": " + test.expectedErr; err.Error() != expected {
+				t.Errorf("Expected error %q, got %q", expected, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package versionfile
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/networkteam/semver"
+)
+
+// YAMLKey returns a source for a scalar value in a YAML file at the given path of mapping keys, e.g.
+// YAMLKey("Chart.yaml", "version"). The formatting of the file (including quotes and comments) is preserved when
+// writing.
+//
+// Only block mappings with the value on the same line as the key are supported, which covers the usual
+// manifests. Flow mappings, anchors and multi-line scalars are not.
+func YAMLKey(path string, keys ...string) Source {
+	return fileSource{path: path, loc: yamlLocator{keys: keys}}
+}
+
+type yamlLocator struct {
+	keys []string
+}
+
+func (l yamlLocator) locate(data []byte) (span, error) {
+	content := string(data)
+	parentIndent := -1
+	childIndent := -1
+	depth := 0
+
+	offset := 0
+	for _, line := range strings.SplitAfter(content, "\n") {
+		lineStart := offset
+		offset += len(line)
+
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || trimmed == "---" {
+			continue
+		}
+
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+		if indent <= parentIndent {
+			break
+		}
+		if childIndent == -1 {
+			childIndent = indent
+		}
+		if indent != childIndent {
+			continue
+		}
+
+		key, rest, found := strings.Cut(trimmed, ":")
+		if !found || strings.Trim(key, `"'`) != l.keys[depth] {
+			continue
+		}
+
+		if depth < len(l.keys)-1 {
+			parentIndent = indent
+			childIndent = -1
+			depth++
+			continue
+		}
+
+		// Locate the scalar after the colon, without a trailing comment
+		valueStart := lineStart + indent + len(key) + 1
+		value := strings.TrimLeft(rest, " \t")
+		valueStart += len(rest) - len(value)
+		if i := strings.Index(value, " #"); i >= 0 {
+			value = value[:i]
+		}
+		value = strings.TrimRight(value, " \t\r\n")
+		if value == "" {
+			return span{}, fmt.Errorf("%s has no scalar value", strings.Join(l.keys, "."))
+		}
+
+		return span{
+			start: valueStart,
+			end:   valueStart + len(value),
+			value: strings.Trim(value, `"'`),
+		}, nil
+	}
+
+	return span{}, fmt.Errorf("key %s not found", strings.Join(l.keys, "."))
+}
+
+func (l yamlLocator) render(s span, data []byte, v *semver.Version) string {
+	switch data[s.start] {
+	case '"', '\'':
+		return string(data[s.start]) + v.String() + string(data[s.start])
+	}
+	return v.String()
+}
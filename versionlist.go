@@ -0,0 +1,234 @@
+package semver
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// VersionList is a sorted set of versions that is safe for concurrent use.
+//
+// Writers are serialized, while readers get a copy-on-write snapshot without taking a lock.
+// Two versions are considered the same element if they are equal and have the same build metadata.
+// The zero value is an empty list ready to use.
+type VersionList struct {
+	mu       sync.Mutex
+	versions atomic.Pointer[[]*Version]
+
+	// subscriptions are replaced on every change, so notifications can iterate them without the lock.
+	subscriptions []*subscription
+}
+
+// ChangeKind describes why a subscriber of a VersionList is notified.
+type ChangeKind int
+
+const (
+	// NewMax is reported when an added version has a higher precedence than all other versions of the list.
+	NewMax ChangeKind = iota + 1
+	// NewStableMax is reported when an added version without pre-release has a higher precedence than all other
+	// versions without pre-release of the list.
+	NewStableMax
+	// Matched is reported when an added version matches the predicate of a subscription.
+	Matched
+)
+
+// Change is a notification about a version added to a VersionList.
+type Change struct {
+	Kind    ChangeKind
+	Version *Version
+}
+
+type subscription struct {
+	match     func(*Version) bool
+	fn        func(Change)
+	cancelled atomic.Bool
+}
+
+// NewVersionList returns a list containing the given versions.
+func NewVersionList(versions ...*Version) *VersionList {
+	l, _ := NewVersionListContext(context.Background(), versions...)
+	return l
+}
+
+// NewVersionListContext returns a list containing the given versions like NewVersionList, but returns the error of
+// the context if it is done before all versions were added.
+func NewVersionListContext(ctx context.Context, versions ...*Version) (*VersionList, error) {
+	sorted := make([]*Version, 0, len(versions))
+	for i, v := range versions {
+		if err := checkContext(ctx, i); err != nil {
+			return nil, err
+		}
+		sorted = append(sorted, v)
+	}
+	// A stable sort keeps versions of equal precedence in the order they would have been added.
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return compareVersions(sorted[i], sorted[j]) < 0
+	})
+
+	unique := sorted[:0]
+	builds := make(map[string]bool)
+	for i, v := range sorted {
+		if err := checkContext(ctx, i); err != nil {
+			return nil, err
+		}
+		if i > 0 && compareVersions(sorted[i-1], v) != 0 {
+			clear(builds)
+		}
+		if !builds[v.Build] {
+			builds[v.Build] = true
+			unique = append(unique, v)
+		}
+	}
+
+	l := &VersionList{}
+	if len(unique) > 0 {
+		l.versions.Store(&unique)
+	}
+	return l, nil
+}
+
+// Snapshot returns the versions of the list in ascending order of precedence.
+// The returned slice is shared between readers and must not be modified, it is not affected by later changes to the list.
+func (l *VersionList) Snapshot() []*Version {
+	p := l.versions.Load()
+	if p == nil {
+		return nil
+	}
+	return *p
+}
+
+// Len returns the number of versions in the list.
+func (l *VersionList) Len() int {
+	return len(l.Snapshot())
+}
+
+// Contains determines if the list contains the given version (including the build metadata).
+func (l *VersionList) Contains(v *Version) bool {
+	_, found := indexOf(l.Snapshot(), v)
+	return found
+}
+
+// Add adds a version to the list and reports whether it was not already present.
+// The version must not be modified after it was added.
+func (l *VersionList) Add(v *Version) bool {
+	l.mu.Lock()
+
+	old := l.Snapshot()
+	i, found := indexOf(old, v)
+	if found {
+		l.mu.Unlock()
+		return false
+	}
+
+	versions := make([]*Version, 0, len(old)+1)
+	versions = append(versions, old[:i]...)
+	versions = append(versions, v)
+	versions = append(versions, old[i:]...)
+	l.versions.Store(&versions)
+
+	subscriptions := l.subscriptions
+	l.mu.Unlock()
+
+	notify(subscriptions, old, v)
+	return true
+}
+
+// Remove removes a version from the list and reports whether it was present.
+func (l *VersionList) Remove(v *Version) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	old := l.Snapshot()
+	i, found := indexOf(old, v)
+	if !found {
+		return false
+	}
+
+	versions := make([]*Version, 0, len(old)-1)
+	versions = append(versions, old[:i]...)
+	versions = append(versions, old[i+1:]...)
+	l.versions.Store(&versions)
+	return true
+}
+
+// Watch registers fn to be notified when a version with a new maximum precedence (NewMax) or a new maximum
+// precedence without pre-release (NewStableMax) is added to the list.
+// Notifications are delivered synchronously by Add after the list was changed, in the order of the subscriptions, so
+// fn may modify the list or cancel subscriptions. Concurrent calls of Add can notify concurrently.
+// The returned function cancels the subscription, fn is not called anymore by Add calls that start after it returned.
+// Notifications already in progress in concurrent Add calls may still call fn after that.
+func (l *VersionList) Watch(fn func(Change)) (cancel func()) {
+	return l.subscribe(&subscription{fn: fn})
+}
+
+// WatchMatching registers fn to be notified (with Matched) when a version matching the given predicate is added to the list.
+// Notifications are delivered and cancelled like for Watch.
+func (l *VersionList) WatchMatching(match func(*Version) bool, fn func(Change)) (cancel func()) {
+	return l.subscribe(&subscription{match: match, fn: fn})
+}
+
+func (l *VersionList) subscribe(s *subscription) func() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.subscriptions = append(slices.Clip(l.subscriptions), s)
+
+	return func() {
+		s.cancelled.Store(true)
+
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if i := slices.Index(l.subscriptions, s); i >= 0 {
+			l.subscriptions = slices.Delete(slices.Clone(l.subscriptions), i, i+1)
+		}
+	}
+}
+
+// notify delivers changes for v being added to the old versions to the subscriptions.
+func notify(subscriptions []*subscription, old []*Version, v *Version) {
+	if len(subscriptions) == 0 {
+		return
+	}
+
+	newMax := len(old) == 0 || old[len(old)-1].Before(v)
+	newStableMax := false
+	if v.PreRelease == "" {
+		newStableMax = true
+		for i := len(old) - 1; i >= 0; i-- {
+			if old[i].PreRelease == "" {
+				newStableMax = old[i].Before(v)
+				break
+			}
+		}
+	}
+
+	for _, s := range subscriptions {
+		if s.match != nil {
+			if s.match(v) && !s.cancelled.Load() {
+				s.fn(Change{Kind: Matched, Version: v})
+			}
+			continue
+		}
+		if newMax && !s.cancelled.Load() {
+			s.fn(Change{Kind: NewMax, Version: v})
+		}
+		if newStableMax && !s.cancelled.Load() {
+			s.fn(Change{Kind: NewStableMax, Version: v})
+		}
+	}
+}
+
+// indexOf returns the position of v in the sorted versions or the position where it would be inserted.
+func indexOf(versions []*Version, v *Version) (int, bool) {
+	i := sort.Search(len(versions), func(i int) bool {
+		return versions[i].AtLeast(v)
+	})
+	for ; i < len(versions) && versions[i].Equals(v); i++ {
+		if versions[i].Build == v.Build {
+			return i, true
+		}
+	}
+	return i, false
+}
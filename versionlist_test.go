@@ -0,0 +1,175 @@
+package semver_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/networkteam/semver"
+)
+
+func mustParse(t *testing.T, version string) *semver.Version {
+	t.Helper()
+	v, err := semver.ParseVersion(version)
+	if err != nil {
+		t.Fatalf("Error parsing version %q: %v", version, err)
+	}
+	return v
+}
+
+func versionStrings(versions []*semver.Version) []string {
+	result := make([]string, len(versions))
+	for i, v := range versions {
+		result[i] = v.String()
+	}
+	return result
+}
+
+func TestVersionList(t *testing.T) {
+	l := semver.NewVersionList(
+		mustParse(t, "1.2.0"),
+		mustParse(t, "1.0.0"),
+		mustParse(t, "1.0.0-rc.1"),
+		mustParse(t, "1.0.0+build.1"),
+	)
+
+	if l.Add(mustParse(t, "1.2.0")) {
+		t.Errorf("Expected adding a present version to return false")
+	}
+	if !l.Add(mustParse(t, "1.1.0")) {
+		t.Errorf("Expected adding a new version to return true")
+	}
+
+	snapshot := l.Snapshot()
+
+	if !l.Remove(mustParse(t, "1.0.0+build.1")) {
+		t.Errorf("Expected removing a present version to return true")
+	}
+	if l.Remove(mustParse(t, "2.0.0")) {
+		t.Errorf("Expected removing a missing version to return false")
+	}
+
+	expected := "[1.0.0-rc.1 1.0.0 1.0.0+build.1 1.1.0 1.2.0]"
+	if got := versionStrings(snapshot); fmt.Sprint(got) != expected {
+		t.Errorf("Expected snapshot %s, got %s", expected, got)
+	}
+
+	expected = "[1.0.0-rc.1 1.0.0 1.1.0 1.2.0]"
+	if got := versionStrings(l.Snapshot()); fmt.Sprint(got) != expected {
+		t.Errorf("Expected versions %s, got %s", expected, got)
+	}
+
+	if !l.Contains(mustParse(t, "1.1.0")) {
+		t.Errorf("Expected list to contain 1.1.0")
+	}
+	if l.Contains(mustParse(t, "1.1.0+other")) {
+		t.Errorf("Expected list to not contain 1.1.0+other")
+	}
+}
+
+func TestVersionList_Concurrent(t *testing.T) {
+	var l semver.VersionList
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				v := &semver.Version{Major: i, Minor: j}
+				l.Add(v)
+				_ = l.Snapshot()
+				if j%2 == 0 {
+					l.Remove(v)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if l.Len() != 8*25 {
+		t.Errorf("Expected %d versions, got %d", 8*25, l.Len())
+	}
+}
+
+func TestVersionList_Watch(t *testing.T) {
+	l := semver.NewVersionList(mustParse(t, "1.0.0"))
+
+	var changes []string
+	cancel := l.Watch(func(c semver.Change) {
+		changes = append(changes, fmt.Sprintf("%d:%s", c.Kind, c.Version))
+	})
+	var matched []string
+	l.WatchMatching(func(v *semver.Version) bool { return v.Major == 2 }, func(c semver.Change) {
+		if c.Kind != semver.Matched {
+			t.Errorf("Expected kind Matched, got %d", c.Kind)
+		}
+		matched = append(matched, c.Version.String())
+	})
+
+	l.Add(mustParse(t, "0.9.0"))
+	l.Add(mustParse(t, "2.0.0-rc.1"))
+	l.Add(mustParse(t, "1.1.0"))
+	l.Add(mustParse(t, "2.0.0"))
+	l.Add(mustParse(t, "2.0.0+build.1"))
+	cancel()
+	l.Add(mustParse(t, "3.0.0"))
+
+	expected := "[1:2.0.0-rc.1 2:1.1.0 1:2.0.0 2:2.0.0]"
+	if fmt.Sprint(changes) != expected {
+		t.Errorf("Expected changes %s, got %s", expected, changes)
+	}
+	expected = "[2.0.0-rc.1 2.0.0 2.0.0+build.1]"
+	if fmt.Sprint(matched) != expected {
+		t.Errorf("Expected matched versions %s, got %s", expected, matched)
+	}
+}
+
+func TestNewVersionList_Duplicates(t *testing.T) {
+	l := semver.NewVersionList(
+		mustParse(t, "2.0.0"),
+		mustParse(t, "1.0.0+b"),
+		mustParse(t, "1.0.0+a"),
+		mustParse(t, "2.0.0"),
+		mustParse(t, "1.0.0+b"),
+		mustParse(t, "1.0.0"),
+	)
+
+	expected := "[1.0.0+b 1.0.0+a 1.0.0 2.0.0]"
+	if got := versionStrings(l.Snapshot()); fmt.Sprint(got) != expected {
+		t.Errorf("Expected versions %s, got %s", expected, got)
+	}
+	if l.Add(mustParse(t, "1.0.0+a")) {
+		t.Errorf("Expected adding a present version to return false")
+	}
+}
+
+func TestVersionList_WatchReentrant(t *testing.T) {
+	var l semver.VersionList
+
+	var changes []string
+	var cancelFirst func()
+	cancelFirst = l.Watch(func(c semver.Change) {
+		changes = append(changes, "first:"+c.Version.String())
+		// Cancelling and modifying the list in a callback must not deadlock.
+		cancelFirst()
+		if c.Version.Major < 2 {
+			l.Add(&semver.Version{Major: c.Version.Major + 1})
+		}
+	})
+	l.Watch(func(c semver.Change) {
+		if c.Kind == semver.NewMax {
+			changes = append(changes, "second:"+c.Version.String())
+		}
+	})
+
+	l.Add(mustParse(t, "1.0.0"))
+
+	expected := "[first:1.0.0 second:2.0.0 second:1.0.0]"
+	if fmt.Sprint(changes) != expected {
+		t.Errorf("Expected changes %s, got %s", expected, changes)
+	}
+	expected = "[1.0.0 2.0.0]"
+	if got := versionStrings(l.Snapshot()); fmt.Sprint(got) != expected {
+		t.Errorf("Expected versions %s, got %s", expected, got)
+	}
+}